@@ -8,6 +8,13 @@ import (
 type appwriteConfig struct {
 	ProjectID *string `cty:"project_id" hcl:"project_id"`
 	SecretKey *string `cty:"secret_key" hcl:"secret_key"`
+	Endpoint  *string `cty:"endpoint" hcl:"endpoint"`
+
+	// RevealSecrets returns the values of variables, which are hidden by default
+	RevealSecrets *bool `cty:"reveal_secrets" hcl:"reveal_secrets"`
+
+	IncludeCollections []string `cty:"include_collections" hcl:"include_collections"`
+	ExcludeCollections []string `cty:"exclude_collections" hcl:"exclude_collections"`
 }
 
 var ConfigSchema = map[string]*schema.Attribute{
@@ -17,6 +24,20 @@ var ConfigSchema = map[string]*schema.Attribute{
 	"secret_key": {
 		Type: schema.TypeString,
 	},
+	"endpoint": {
+		Type: schema.TypeString,
+	},
+	"reveal_secrets": {
+		Type: schema.TypeBool,
+	},
+	"include_collections": {
+		Type: schema.TypeList,
+		Elem: &schema.Attribute{Type: schema.TypeString},
+	},
+	"exclude_collections": {
+		Type: schema.TypeList,
+		Elem: &schema.Attribute{Type: schema.TypeString},
+	},
 }
 
 func ConfigInstance() interface{} {
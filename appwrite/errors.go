@@ -0,0 +1,90 @@
+package appwrite
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// appwriteError is an error response from the Appwrite API, e.g.
+// {"message": "User with the requested ID could not be found.", "code": 404, "type": "user_not_found"}
+type appwriteError struct {
+	Code    int    `json:"code"`
+	Type    string `json:"type"`
+	Message string `json:"message"`
+
+	// RateLimitReset is when the rate limit window resets, taken from the
+	// X-RateLimit-Reset header of a 429 response. Zero if not present.
+	RateLimitReset time.Time `json:"-"`
+}
+
+func (e *appwriteError) Error() string {
+	if e.Type == "" {
+		return fmt.Sprintf("appwrite: %s (code: %d)", e.Message, e.Code)
+	}
+	return fmt.Sprintf("appwrite: %s (code: %d, type: %s)", e.Message, e.Code, e.Type)
+}
+
+// newAppwriteError builds an appwriteError from an error response, falling
+// back to the HTTP status when the body isn't an Appwrite error.
+func newAppwriteError(resp *http.Response, body []byte) *appwriteError {
+	apiErr := &appwriteError{}
+	if err := json.Unmarshal(body, apiErr); err != nil || apiErr.Message == "" {
+		apiErr.Message = strings.TrimSpace(string(body))
+		if apiErr.Message == "" {
+			apiErr.Message = http.StatusText(resp.StatusCode)
+		}
+	}
+	// Trust the status over the body, which may omit the code
+	apiErr.Code = resp.StatusCode
+
+	if reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		apiErr.RateLimitReset = time.Unix(reset, 0)
+	}
+	return apiErr
+}
+
+// shouldRetryError retries rate limited requests and transient server errors.
+// appwriteClient.get holds back the retry of a rate limited request until the
+// limit resets, if that is later than the backoff of the retry config.
+func shouldRetryError(_ context.Context, _ *plugin.QueryData, _ *plugin.HydrateData, err error) bool {
+	var apiErr *appwriteError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.Code {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable:
+		return true
+	}
+	return false
+}
+
+// shouldIgnoreError ignores requests for resources that don't exist, so that
+// they return no rows rather than failing the query.
+func shouldIgnoreError(_ context.Context, _ *plugin.QueryData, _ *plugin.HydrateData, err error) bool {
+	return isNotFoundError(err)
+}
+
+// isNotFoundError reports whether err is an Appwrite error for a missing
+// resource. Appwrite answers some requests for missing resources, e.g. the
+// documents of a deleted collection, with a 401 whose type ends in _not_found.
+func isNotFoundError(err error) bool {
+	var apiErr *appwriteError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.Code {
+	case http.StatusNotFound:
+		return true
+	case http.StatusUnauthorized:
+		return strings.HasSuffix(apiErr.Type, "_not_found")
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package appwrite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewAppwriteError(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		status int
+		header string
+		body   string
+		want   appwriteError
+	}{
+		{"appwrite error", http.StatusNotFound, "", `{"message": "User not found", "code": 404, "type": "user_not_found"}`, appwriteError{Code: 404, Type: "user_not_found", Message: "User not found"}},
+		{"status over body code", http.StatusBadGateway, "", `{"message": "Bad gateway", "code": 0}`, appwriteError{Code: 502, Message: "Bad gateway"}},
+		{"plain text body", http.StatusServiceUnavailable, "", "upstream down\n", appwriteError{Code: 503, Message: "upstream down"}},
+		{"empty body", http.StatusInternalServerError, "", "", appwriteError{Code: 500, Message: "Internal Server Error"}},
+		{"rate limit reset", http.StatusTooManyRequests, "1700000000", `{"message": "Rate limit", "code": 429, "type": "general_rate_limit_exceeded"}`, appwriteError{Code: 429, Type: "general_rate_limit_exceeded", Message: "Rate limit", RateLimitReset: time.Unix(1700000000, 0)}},
+		{"invalid rate limit reset", http.StatusTooManyRequests, "soon", `{"message": "Rate limit", "code": 429}`, appwriteError{Code: 429, Message: "Rate limit"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: tc.status, Header: http.Header{}}
+			if tc.header != "" {
+				resp.Header.Set("X-RateLimit-Reset", tc.header)
+			}
+			got := newAppwriteError(resp, []byte(tc.body))
+			if got.Code != tc.want.Code || got.Type != tc.want.Type || got.Message != tc.want.Message || !got.RateLimitReset.Equal(tc.want.RateLimitReset) {
+				t.Errorf("error = %+v, want %+v", *got, tc.want)
+			}
+		})
+	}
+}
+
+func TestShouldRetryError(t *testing.T) {
+	for err, want := range map[error]bool{
+		&appwriteError{Code: http.StatusTooManyRequests}:                          true,
+		&appwriteError{Code: http.StatusInternalServerError}:                      true,
+		&appwriteError{Code: http.StatusBadGateway}:                               true,
+		&appwriteError{Code: http.StatusServiceUnavailable}:                       true,
+		fmt.Errorf("page 2: %w", &appwriteError{Code: http.StatusBadGateway}):     true,
+		&appwriteError{Code: http.StatusBadRequest}:                               false,
+		&appwriteError{Code: http.StatusUnauthorized}:                             false,
+		&appwriteError{Code: http.StatusNotFound}:                                 false,
+		errors.New("dial tcp: connection refused"):                                false,
+		fmt.Errorf("page 2: %w", &appwriteError{Code: http.StatusNotImplemented}): false,
+	} {
+		if got := shouldRetryError(context.Background(), nil, nil, err); got != want {
+			t.Errorf("shouldRetryError(%v) = %v, want %v", err, got, want)
+		}
+	}
+}
+
+func TestGetWaitsForRateLimitReset(t *testing.T) {
+	clearEnv(t)
+	reset := time.Now().Add(2 * time.Second).Truncate(time.Second)
+	var mu sync.Mutex
+	var requestTimes []time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requestTimes = append(requestTimes, time.Now())
+		w.Header().Set("Content-Type", "application/json")
+		if len(requestTimes) == 1 {
+			w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"message": "Rate limit for the current endpoint has been exceeded.", "code": 429, "type": "general_rate_limit_exceeded"}`))
+			return
+		}
+		_, _ = w.Write([]byte("{}"))
+	}))
+	t.Cleanup(server.Close)
+	conn := clientSettings{Endpoint: server.URL + "/v1", ProjectID: "project", SecretKey: "key"}.client()
+
+	_, err := conn.get(testContext(), "/users", nil)
+	if !shouldRetryError(context.Background(), nil, nil, err) {
+		t.Fatalf("error = %v, want a rate limit error to retry", err)
+	}
+
+	// A query cancelled before the limit resets gives up without a request
+	ctx, cancel := context.WithTimeout(testContext(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := conn.get(ctx, "/users", nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("error = %v, want the deadline exceeded", err)
+	}
+
+	if _, err := conn.get(testContext(), "/users", nil); err != nil {
+		t.Fatalf("get after the reset: %v", err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(requestTimes) != 2 {
+		t.Fatalf("%d requests, want 2", len(requestTimes))
+	}
+	if requestTimes[1].Before(reset) {
+		t.Errorf("request retried at %v, before the rate limit reset at %v", requestTimes[1], reset)
+	}
+}
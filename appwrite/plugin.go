@@ -15,21 +15,70 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			Schema:      ConfigSchema,
 		},
 		DefaultTransform: transform.FromGo().NullIfZero(),
-		DefaultGetConfig: &plugin.GetConfig{
-			ShouldIgnoreError: isNotFoundError,
+		DefaultRetryConfig: &plugin.RetryConfig{
+			ShouldRetryErrorFunc: shouldRetryError,
+			MaxAttempts:          5,
+			BackoffAlgorithm:     "Exponential",
+			RetryInterval:        500,
+			CappedDuration:       10000,
 		},
-		TableMap: map[string]*plugin.Table{
-			"appwrite_bucket":     tableAppwriteBucket(ctx),
-			"appwrite_collection": tableAppwriteCollection(ctx),
-			"appwrite_database":   tableAppwriteDatabase(ctx),
-			"appwrite_document":   tableAppwriteDocument(ctx),
-			"appwrite_deployment": tableAppwriteDeployment(ctx),
-			"appwrite_execution":  tableAppwriteExecution(ctx),
-			"appwrite_file":       tableAppwriteFile(ctx),
-			"appwrite_function":   tableAppwriteFunction(ctx),
-			"appwrite_health":     tableAppwriteHealth(ctx),
-			"appwrite_user":       tableAppwriteUser(ctx),
+		DefaultIgnoreConfig: &plugin.IgnoreConfig{
+			ShouldIgnoreErrorFunc: shouldIgnoreError,
 		},
+		SchemaMode:   plugin.SchemaModeDynamic,
+		TableMapFunc: pluginTableDefinitions,
 	}
 	return p
 }
+
+func pluginTableDefinitions(ctx context.Context, d *plugin.TableMapData) (map[string]*plugin.Table, error) {
+	tables := map[string]*plugin.Table{
+		"appwrite_bucket":               tableAppwriteBucket(ctx),
+		"appwrite_collection":           tableAppwriteCollection(ctx),
+		"appwrite_collection_attribute": tableAppwriteCollectionAttribute(ctx),
+		"appwrite_collection_index":     tableAppwriteCollectionIndex(ctx),
+		"appwrite_database":             tableAppwriteDatabase(ctx),
+		"appwrite_document":             tableAppwriteDocument(ctx),
+		"appwrite_deployment":           tableAppwriteDeployment(ctx),
+		"appwrite_execution":            tableAppwriteExecution(ctx),
+		"appwrite_file":                 tableAppwriteFile(ctx),
+		"appwrite_file_preview":         tableAppwriteFilePreview(ctx),
+		"appwrite_function":             tableAppwriteFunction(ctx),
+		"appwrite_function_variable":    tableAppwriteFunctionVariable(ctx),
+		"appwrite_health":               tableAppwriteHealth(ctx),
+		"appwrite_locale":               tableAppwriteLocale(ctx),
+		"appwrite_locale_continent":     tableAppwriteLocaleContinent(ctx),
+		"appwrite_locale_country":       tableAppwriteLocaleCountry(ctx),
+		"appwrite_locale_currency":      tableAppwriteLocaleCurrency(ctx),
+		"appwrite_locale_language":      tableAppwriteLocaleLanguage(ctx),
+		"appwrite_locale_phone_code":    tableAppwriteLocalePhoneCode(ctx),
+		"appwrite_messaging_message":    tableAppwriteMessagingMessage(ctx),
+		"appwrite_messaging_provider":   tableAppwriteMessagingProvider(ctx),
+		"appwrite_messaging_subscriber": tableAppwriteMessagingSubscriber(ctx),
+		"appwrite_messaging_topic":      tableAppwriteMessagingTopic(ctx),
+		"appwrite_project_variable":     tableAppwriteProjectVariable(ctx),
+		"appwrite_team":                 tableAppwriteTeam(ctx),
+		"appwrite_team_membership":      tableAppwriteTeamMembership(ctx),
+		"appwrite_usage_database":       tableAppwriteUsageDatabase(ctx),
+		"appwrite_usage_function":       tableAppwriteUsageFunction(ctx),
+		"appwrite_usage_storage":        tableAppwriteUsageStorage(ctx),
+		"appwrite_usage_user":           tableAppwriteUsageUser(ctx),
+		"appwrite_user":                 tableAppwriteUser(ctx),
+		"appwrite_user_identity":        tableAppwriteUserIdentity(ctx),
+		"appwrite_user_log":             tableAppwriteUserLog(ctx),
+		"appwrite_user_session":         tableAppwriteUserSession(ctx),
+	}
+
+	// A connection without credentials, or a server that can't be reached,
+	// should still expose the static tables
+	documentTables, err := documentTableDefinitions(ctx, d.Connection)
+	if err != nil {
+		plugin.Logger(ctx).Warn("pluginTableDefinitions", "connection", d.Connection.Name, "document_tables_error", err)
+		return tables, nil
+	}
+	for name, table := range documentTables {
+		tables[name] = table
+	}
+
+	return tables, nil
+}
@@ -0,0 +1,172 @@
+package appwrite
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/quals"
+)
+
+// queryColumn is a column whose quals can be pushed down to Appwrite as
+// queries on the given attribute.
+type queryColumn struct {
+	Name      string
+	Attribute string
+	Operators []string
+}
+
+var (
+	stringOperators = []string{
+		quals.QualOperatorEqual,
+		quals.QualOperatorNotEqual,
+		quals.QualOperatorLess,
+		quals.QualOperatorLessOrEqual,
+		quals.QualOperatorGreater,
+		quals.QualOperatorGreaterOrEqual,
+		quals.QualOperatorLike,
+		quals.QualOperatorIsNull,
+		quals.QualOperatorIsNotNull,
+	}
+	numberOperators = []string{
+		quals.QualOperatorEqual,
+		quals.QualOperatorNotEqual,
+		quals.QualOperatorLess,
+		quals.QualOperatorLessOrEqual,
+		quals.QualOperatorGreater,
+		quals.QualOperatorGreaterOrEqual,
+		quals.QualOperatorIsNull,
+		quals.QualOperatorIsNotNull,
+	}
+	boolOperators = []string{
+		quals.QualOperatorEqual,
+		quals.QualOperatorNotEqual,
+	}
+)
+
+// queryKeyColumns returns optional key columns for the query columns.
+func queryKeyColumns(columns []queryColumn) []*plugin.KeyColumn {
+	keyColumns := make([]*plugin.KeyColumn, 0, len(columns))
+	for _, column := range columns {
+		keyColumns = append(keyColumns, &plugin.KeyColumn{
+			Name:      column.Name,
+			Operators: column.Operators,
+			Require:   plugin.Optional,
+		})
+	}
+	return keyColumns
+}
+
+// appwriteQuery is a query in the JSON format of Appwrite 1.5 and later.
+type appwriteQuery struct {
+	Method    string        `json:"method"`
+	Attribute string        `json:"attribute,omitempty"`
+	Values    []interface{} `json:"values,omitempty"`
+}
+
+// newQuery encodes an Appwrite query, e.g. newQuery("limit", "", 25) becomes
+// {"method":"limit","values":[25]}.
+func newQuery(method string, attribute string, values ...interface{}) string {
+	return quoteQueryValue(appwriteQuery{Method: method, Attribute: attribute, Values: values})
+}
+
+// buildQueries translates the quals on the query columns into Appwrite
+// queries, e.g. name = 'foo' becomes
+// {"method":"equal","attribute":"name","values":["foo"]}. Quals which can't be
+// expressed as a query are left for Steampipe to filter.
+func buildQueries(keyQuals plugin.KeyColumnQualMap, columns []queryColumn) []string {
+	var queries []string
+	for _, column := range columns {
+		columnQuals, ok := keyQuals[column.Name]
+		if !ok {
+			continue
+		}
+		for _, qual := range columnQuals.Quals {
+			if query, ok := qualToQuery(column.Attribute, qual); ok {
+				queries = append(queries, query)
+			}
+		}
+	}
+	return queries
+}
+
+func qualToQuery(attribute string, qual *quals.Qual) (string, bool) {
+	switch qual.Operator {
+	case quals.QualOperatorIsNull:
+		return newQuery("isNull", attribute), true
+	case quals.QualOperatorIsNotNull:
+		return newQuery("isNotNull", attribute), true
+	case quals.QualOperatorLike:
+		// Only prefix patterns such as 'abc%' have an Appwrite equivalent
+		pattern := qual.Value.GetStringValue()
+		prefix := strings.TrimSuffix(pattern, "%")
+		if prefix == pattern || prefix == "" || strings.ContainsAny(prefix, `%_\`) {
+			return "", false
+		}
+		return newQuery("startsWith", attribute, prefix), true
+	}
+
+	method, ok := map[string]string{
+		quals.QualOperatorEqual:          "equal",
+		quals.QualOperatorNotEqual:       "notEqual",
+		quals.QualOperatorLess:           "lessThan",
+		quals.QualOperatorLessOrEqual:    "lessThanEqual",
+		quals.QualOperatorGreater:        "greaterThan",
+		quals.QualOperatorGreaterOrEqual: "greaterThanEqual",
+	}[qual.Operator]
+	if !ok {
+		return "", false
+	}
+
+	var values []interface{}
+	if list := qual.Value.GetListValue(); list != nil {
+		// Only equal accepts several values, matching any of them
+		if qual.Operator != quals.QualOperatorEqual {
+			return "", false
+		}
+		for _, v := range list.Values {
+			value, ok := queryValue(v)
+			if !ok {
+				return "", false
+			}
+			values = append(values, value)
+		}
+	} else {
+		value, ok := queryValue(qual.Value)
+		if !ok {
+			return "", false
+		}
+		values = append(values, value)
+	}
+	return newQuery(method, attribute, values...), true
+}
+
+// queryValue converts a qual value into the value Appwrite expects in a query.
+func queryValue(value *proto.QualValue) (interface{}, bool) {
+	switch v := value.GetValue().(type) {
+	case *proto.QualValue_StringValue:
+		return v.StringValue, true
+	case *proto.QualValue_Int64Value:
+		return v.Int64Value, true
+	case *proto.QualValue_DoubleValue:
+		return v.DoubleValue, true
+	case *proto.QualValue_BoolValue:
+		return v.BoolValue, true
+	case *proto.QualValue_TimestampValue:
+		return v.TimestampValue.AsTime().UTC().Format(time.RFC3339Nano), true
+	default:
+		return nil, false
+	}
+}
+
+// quoteQueryValue encodes value as JSON without escaping HTML characters,
+// which Appwrite would otherwise compare as their escapes.
+func quoteQueryValue(value interface{}) string {
+	var b strings.Builder
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(value)
+	return strings.TrimSuffix(b.String(), "\n")
+}
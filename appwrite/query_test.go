@@ -0,0 +1,114 @@
+package appwrite
+
+import (
+	"testing"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/quals"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func stringValue(s string) *proto.QualValue {
+	return &proto.QualValue{Value: &proto.QualValue_StringValue{StringValue: s}}
+}
+
+func TestQualToQuery(t *testing.T) {
+	createdAt := time.Date(2024, 3, 1, 12, 30, 0, 500000000, time.FixedZone("CET", 3600))
+	for _, tc := range []struct {
+		name     string
+		operator string
+		value    *proto.QualValue
+		want     string
+	}{
+		{"equal", quals.QualOperatorEqual, stringValue("foo"), `{"method":"equal","attribute":"name","values":["foo"]}`},
+		{"not equal", quals.QualOperatorNotEqual, stringValue("foo"), `{"method":"notEqual","attribute":"name","values":["foo"]}`},
+		{"less", quals.QualOperatorLess, &proto.QualValue{Value: &proto.QualValue_Int64Value{Int64Value: 10}}, `{"method":"lessThan","attribute":"name","values":[10]}`},
+		{"less or equal", quals.QualOperatorLessOrEqual, &proto.QualValue{Value: &proto.QualValue_DoubleValue{DoubleValue: 1.5}}, `{"method":"lessThanEqual","attribute":"name","values":[1.5]}`},
+		{"greater", quals.QualOperatorGreater, stringValue("b"), `{"method":"greaterThan","attribute":"name","values":["b"]}`},
+		{"greater or equal", quals.QualOperatorGreaterOrEqual, stringValue("b"), `{"method":"greaterThanEqual","attribute":"name","values":["b"]}`},
+		{"is null", quals.QualOperatorIsNull, nil, `{"method":"isNull","attribute":"name"}`},
+		{"is not null", quals.QualOperatorIsNotNull, nil, `{"method":"isNotNull","attribute":"name"}`},
+		{"in", quals.QualOperatorEqual, &proto.QualValue{Value: &proto.QualValue_ListValue{ListValue: &proto.QualValueList{Values: []*proto.QualValue{stringValue("a"), stringValue("b")}}}}, `{"method":"equal","attribute":"name","values":["a","b"]}`},
+		{"bool", quals.QualOperatorEqual, &proto.QualValue{Value: &proto.QualValue_BoolValue{BoolValue: true}}, `{"method":"equal","attribute":"name","values":[true]}`},
+		{"timestamp", quals.QualOperatorGreater, &proto.QualValue{Value: &proto.QualValue_TimestampValue{TimestampValue: timestamppb.New(createdAt)}}, `{"method":"greaterThan","attribute":"name","values":["2024-03-01T11:30:00.5Z"]}`},
+		{"like prefix", quals.QualOperatorLike, stringValue("foo%"), `{"method":"startsWith","attribute":"name","values":["foo"]}`},
+		{"quoting", quals.QualOperatorEqual, stringValue(`say "hi" \ <b>`), `{"method":"equal","attribute":"name","values":["say \"hi\" \\ <b>"]}`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := qualToQuery("name", &quals.Qual{Column: "name", Operator: tc.operator, Value: tc.value})
+			if !ok || got != tc.want {
+				t.Errorf("query = %s, %v, want %s", got, ok, tc.want)
+			}
+		})
+	}
+}
+
+func TestQualToQueryLeavesUnsupportedQuals(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		operator string
+		value    *proto.QualValue
+	}{
+		{"like without wildcard", quals.QualOperatorLike, stringValue("foo")},
+		{"like suffix", quals.QualOperatorLike, stringValue("%foo")},
+		{"like infix", quals.QualOperatorLike, stringValue("f%o%")},
+		{"like single character", quals.QualOperatorLike, stringValue("f_o%")},
+		{"like escape", quals.QualOperatorLike, stringValue(`f\%o%`)},
+		{"like everything", quals.QualOperatorLike, stringValue("%")},
+		{"ilike", quals.QualOperatorILike, stringValue("foo%")},
+		{"regex", quals.QualOperatorRegex, stringValue("^foo")},
+		{"not in", quals.QualOperatorNotEqual, &proto.QualValue{Value: &proto.QualValue_ListValue{ListValue: &proto.QualValueList{Values: []*proto.QualValue{stringValue("a")}}}}},
+		{"json", quals.QualOperatorEqual, &proto.QualValue{Value: &proto.QualValue_JsonbValue{JsonbValue: "{}"}}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got, ok := qualToQuery("name", &quals.Qual{Column: "name", Operator: tc.operator, Value: tc.value}); ok {
+				t.Errorf("query = %s, want the qual left to Steampipe", got)
+			}
+		})
+	}
+}
+
+func TestBuildQueries(t *testing.T) {
+	columns := []queryColumn{
+		{Name: "name", Attribute: "name", Operators: stringOperators},
+		{Name: "created_at", Attribute: "$createdAt", Operators: numberOperators},
+	}
+	keyQuals := plugin.KeyColumnQualMap{
+		"name": {Name: "name", Quals: quals.QualSlice{
+			{Column: "name", Operator: quals.QualOperatorLike, Value: stringValue("a%")},
+			{Column: "name", Operator: quals.QualOperatorLike, Value: stringValue("%z")},
+		}},
+		"created_at": {Name: "created_at", Quals: quals.QualSlice{
+			{Column: "created_at", Operator: quals.QualOperatorIsNotNull},
+		}},
+		"enabled": {Name: "enabled", Quals: quals.QualSlice{
+			{Column: "enabled", Operator: quals.QualOperatorEqual, Value: &proto.QualValue{Value: &proto.QualValue_BoolValue{BoolValue: true}}},
+		}},
+	}
+
+	got := buildQueries(keyQuals, columns)
+	want := []string{
+		`{"method":"startsWith","attribute":"name","values":["a"]}`,
+		`{"method":"isNotNull","attribute":"$createdAt"}`,
+	}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("queries = %v, want %v", got, want)
+	}
+}
+
+func TestQuoteQueryValue(t *testing.T) {
+	for value, want := range map[interface{}]string{
+		`a"b`:  `"a\"b"`,
+		`a\b`:  `"a\\b"`,
+		`<&>`:  `"<&>"`,
+		"$id":  `"$id"`,
+		true:   `true`,
+		int(3): `3`,
+	} {
+		if got := quoteQueryValue(value); got != want {
+			t.Errorf("quoteQueryValue(%#v) = %s, want %s", value, got, want)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package appwrite
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	appwrite "github.com/mr-destructive/appwrite-go-sdk"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
@@ -16,19 +17,23 @@ func tableAppwriteBucket(ctx context.Context) *plugin.Table {
 		Description: "Query buckets meta information from an appwrite project",
 		List: &plugin.ListConfig{
 			Hydrate: listBuckets,
-			KeyColumns: []*plugin.KeyColumn{
+			KeyColumns: append([]*plugin.KeyColumn{
 				{Name: "search_query", Require: plugin.Optional},
 				{Name: "settings", Require: plugin.Optional},
-			},
+			}, queryKeyColumns(bucketQueryColumns)...),
 		},
-		Columns: []*plugin.Column{
+		Get: &plugin.GetConfig{
+			Hydrate:    getBucket,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		Columns: commonColumns([]*plugin.Column{
 			// Result columns
 			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "The ID of the bucket."},
 			{Name: "title", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "The Name or ID of the bucket."},
 			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "The name of the bucket."},
 			{Name: "file_extensions", Type: proto.ColumnType_STRING, Transform: transform.FromField("AllowedFileExtensions"), Description: "The allowed file extensions for the bucket."},
-			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.FromField("CreatedAt"), Description: "Bucket creation time in ISO 8601 format."},
-			{Name: "updated_at", Type: proto.ColumnType_STRING, Transform: transform.FromField("UpdatedAt"), Description: "Bucket update time in ISO 8601 format"},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("CreatedAt").Transform(toTimestamp), Description: "Bucket creation time."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("UpdatedAt").Transform(toTimestamp), Description: "Bucket update time."},
 			{Name: "permissions", Type: proto.ColumnType_JSON, Transform: transform.FromField("Permissions"), Description: "The permission setting(list of strings) for the bucket."},
 			{Name: "file_security", Type: proto.ColumnType_BOOL, Transform: transform.FromField("FileSecurity"), Description: "A boolean value/flag for file-level security is enabled on the bucket."},
 			{Name: "enabled", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Enabled"), Description: "Flag for checking if the bucket is enabled or disabled as storage."},
@@ -37,10 +42,17 @@ func tableAppwriteBucket(ctx context.Context) *plugin.Table {
 			{Name: "encryption", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Encryption"), Description: "A boolean value for chacking if encryption is enabled in the bucket or not."},
 			{Name: "antivirus", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Antivirus"), Description: "A boolean value for chacking if the virus scanning is enabled in the bucket or not."},
 
+			// Statistics computed from the bucket's files
+			{Name: "file_count", Type: proto.ColumnType_INT, Hydrate: getBucketStats, Transform: transform.FromField("FileCount"), Description: "The number of files in the bucket."},
+			{Name: "total_size_bytes", Type: proto.ColumnType_INT, Hydrate: getBucketStats, Transform: transform.FromField("TotalSizeBytes"), Description: "The total original size of the files in the bucket in bytes."},
+			{Name: "largest_file_bytes", Type: proto.ColumnType_INT, Hydrate: getBucketStats, Transform: transform.FromField("LargestFileBytes"), Description: "The original size of the largest file in the bucket in bytes."},
+			{Name: "mime_type_breakdown", Type: proto.ColumnType_JSON, Hydrate: getBucketStats, Transform: transform.FromField("MimeTypes"), Description: "The number of files and their total size in bytes per MIME type, e.g. {\"image/png\": {\"count\": 2, \"size_bytes\": 1024}}."},
+			{Name: "oldest_file_at", Type: proto.ColumnType_TIMESTAMP, Hydrate: getBucketStats, Transform: transform.FromField("OldestFileAt"), Description: "The creation time of the oldest file in the bucket."},
+
 			// Input Columns
 			{Name: "search_query", Type: proto.ColumnType_STRING, Transform: transform.FromField("Search"), Description: "The string value to filter the results from the request."},
 			{Name: "settings", Type: proto.ColumnType_JSON, Transform: transform.FromQual("settings"), Description: "Settings is a JSONB object that accepts any of the completion API request parameters."},
-		},
+		}),
 	}
 }
 
@@ -48,6 +60,18 @@ type bucketsRequestQual struct {
 	Search *string
 }
 
+var bucketQueryColumns = []queryColumn{
+	{Name: "id", Attribute: "$id", Operators: stringOperators},
+	{Name: "name", Attribute: "name", Operators: stringOperators},
+	{Name: "created_at", Attribute: "$createdAt", Operators: numberOperators},
+	{Name: "updated_at", Attribute: "$updatedAt", Operators: numberOperators},
+	{Name: "file_security", Attribute: "fileSecurity", Operators: boolOperators},
+	{Name: "enabled", Attribute: "enabled", Operators: boolOperators},
+	{Name: "maximum_file_size", Attribute: "maximumFileSize", Operators: numberOperators},
+	{Name: "encryption", Attribute: "encryption", Operators: boolOperators},
+	{Name: "antivirus", Attribute: "antivirus", Operators: boolOperators},
+}
+
 type bucketsRow struct {
 	appwrite.Bucket
 	Search string
@@ -73,22 +97,133 @@ func listBuckets(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData
 		}
 	}
 
-	storage := appwrite.Storage{
-		Client: *conn,
-	}
 	search := d.EqualsQuals["search_query"].GetStringValue()
 
-	bucketsList, err := storage.ListBuckets(search, 0, 0, "")
+	queries := buildQueries(d.Quals, bucketQueryColumns)
+
+	err = listAll(ctx, d, conn, "/storage/buckets", "buckets", searchParams(search), queries, func(bucket appwrite.Bucket) {
+		row := bucketsRow{bucket, search}
+		d.StreamListItem(ctx, row)
+	})
 	if err != nil {
 		plugin.Logger(ctx).Error("appwrite_bucket.listBuckets", "api_error", err)
 		return nil, err
 	}
-	plugin.Logger(ctx).Trace("appwrite_bucket.listBuckets", "response", bucketsList)
-	buckers := *bucketsList
-	for _, bucket := range buckers.Buckets {
-		row := bucketsRow{bucket, search}
-		d.StreamListItem(ctx, row)
+
+	return nil, nil
+}
+
+func getBucket(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQuals["id"].GetStringValue()
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_bucket.getBucket", "connection_error", err)
+		return nil, err
+	}
+
+	bucket, err := getOne[appwrite.Bucket](ctx, conn, "/storage/buckets/%s", id)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_bucket.getBucket", "api_error", err)
+		return nil, err
 	}
 
+	return bucketsRow{Bucket: bucket}, nil
+}
+
+// listParentBuckets is the parent list of tables nested under buckets. It
+// streams the bucket given by bucket_id, or every bucket otherwise.
+func listParentBuckets(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	if bucketId := d.EqualsQuals["bucket_id"].GetStringValue(); bucketId != "" {
+		d.StreamListItem(ctx, appwrite.Bucket{Id: bucketId})
+		return nil, nil
+	}
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_bucket.listParentBuckets", "connection_error", err)
+		return nil, err
+	}
+
+	err = listAll(ctx, d, conn, "/storage/buckets", "buckets", nil, nil, func(bucket appwrite.Bucket) {
+		d.StreamListItem(ctx, bucket)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_bucket.listParentBuckets", "api_error", err)
+		return nil, err
+	}
 	return nil, nil
 }
+
+// bucketStatsTTL is how long the statistics of a bucket are cached.
+const bucketStatsTTL = 10 * time.Minute
+
+// bucketStats are statistics of a bucket computed from its files.
+type bucketStats struct {
+	FileCount        int64
+	TotalSizeBytes   int64
+	LargestFileBytes int64
+	MimeTypes        map[string]*mimeTypeStats
+	OldestFileAt     *time.Time
+}
+
+type mimeTypeStats struct {
+	Count     int64 `json:"count"`
+	SizeBytes int64 `json:"size_bytes"`
+}
+
+// getBucketStats pages through every file of the bucket to compute its
+// statistics. They're cached per bucket, so that the files are only listed
+// once however many statistics columns are selected.
+func getBucketStats(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return getBucketStatsCached(ctx, d, h)
+}
+
+var getBucketStatsCached = plugin.HydrateFunc(computeBucketStats).Memoize(func(config *plugin.MemoizeConfiguration) {
+	config.Ttl = bucketStatsTTL
+	config.GetCacheKeyFunc = func(_ context.Context, _ *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+		return "appwrite_bucket.getBucketStats/" + h.Item.(bucketsRow).Id, nil
+	}
+})
+
+func computeBucketStats(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bucketId := h.Item.(bucketsRow).Id
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_bucket.getBucketStats", "connection_error", err)
+		return nil, err
+	}
+
+	stats := &bucketStats{MimeTypes: map[string]*mimeTypeStats{}}
+	path := apiPath("/storage/buckets/%s/files", bucketId)
+	// Without query data, so that the query's limit doesn't cut the listing short
+	err = listAll(ctx, nil, conn, path, "files", nil, nil, func(f appwrite.File) {
+		size := int64(f.SizeOriginal)
+		stats.FileCount++
+		stats.TotalSizeBytes += size
+		if size > stats.LargestFileBytes {
+			stats.LargestFileBytes = size
+		}
+
+		mimeType := stats.MimeTypes[f.MimeType]
+		if mimeType == nil {
+			mimeType = &mimeTypeStats{}
+			stats.MimeTypes[f.MimeType] = mimeType
+		}
+		mimeType.Count++
+		mimeType.SizeBytes += size
+
+		if createdAt, ok := parseAppwriteTime(f.CreatedAt); ok {
+			if stats.OldestFileAt == nil || createdAt.Before(*stats.OldestFileAt) {
+				stats.OldestFileAt = &createdAt
+			}
+		}
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_bucket.getBucketStats", "api_error", err)
+		return nil, err
+	}
+
+	return stats, nil
+}
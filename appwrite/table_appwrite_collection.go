@@ -15,21 +15,25 @@ func tableAppwriteCollection(ctx context.Context) *plugin.Table {
 		Name:        "appwrite_collection",
 		Description: "Query collections of an appwrite database.",
 		List: &plugin.ListConfig{
-			Hydrate: listCollections,
-			KeyColumns: []*plugin.KeyColumn{
+			ParentHydrate: listParentDatabases,
+			Hydrate:       childList(listCollections),
+			KeyColumns: append([]*plugin.KeyColumn{
 				{Name: "database_id", Require: plugin.Optional},
 				{Name: "search_query", Require: plugin.Optional},
-				{Name: "query", Require: plugin.Optional},
 				{Name: "settings", Require: plugin.Optional},
-			},
+			}, queryKeyColumns(collectionQueryColumns)...),
 		},
-		Columns: []*plugin.Column{
+		Get: &plugin.GetConfig{
+			Hydrate:    getCollection,
+			KeyColumns: plugin.AllColumns([]string{"database_id", "id"}),
+		},
+		Columns: commonColumns([]*plugin.Column{
 			// Result columns
 			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Collection.Id"), Description: "The unique ID of the collection."},
 			{Name: "title", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "The Name or ID of the collection."},
 			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Collection.Name"), Description: "The Name of the collection."},
-			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.FromField("Collection.CreatedAt"), Description: "Collection creation date in ISO 8601 format."},
-			{Name: "updated_at", Type: proto.ColumnType_STRING, Transform: transform.FromField("Collection.UpdatedAt"), Description: "Collection updation date in ISO 8601 format."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Collection.CreatedAt").Transform(toTimestamp), Description: "Collection creation date."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Collection.UpdatedAt").Transform(toTimestamp), Description: "Collection updation date."},
 			{Name: "document_security", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Collection.DocumentSecurity"), Description: "A boolean value for checking if the document-level permissions are enabled or not."},
 			{Name: "permissions", Type: proto.ColumnType_JSON, Transform: transform.FromField("Collection.Permissions"), Description: "The permission settings(list of strings) for the collection access."},
 			{Name: "enabled", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Collection.Enabled"), Description: "A boolean value for checking if the collection is enabled or not."},
@@ -37,27 +41,33 @@ func tableAppwriteCollection(ctx context.Context) *plugin.Table {
 			{Name: "indexes", Type: proto.ColumnType_JSON, Transform: transform.FromField("Collection.Indexes"), Description: "A list of indexes for the collection."},
 
 			// Input Columns
-			{Name: "database_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("DatabaseId"), Description: "The ID of the database to get collections from."},
+			{Name: "database_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Collection.DatabaseId"), Description: "The ID of the database to get collections from."},
 			{Name: "search_query", Type: proto.ColumnType_STRING, Transform: transform.FromField("Search"), Description: "The search string as filter for the request."},
-			{Name: "query", Type: proto.ColumnType_STRING, Transform: transform.FromField("Query"), Description: "A string of query type as filter for the request."},
 			{Name: "settings", Type: proto.ColumnType_JSON, Transform: transform.FromQual("settings"), Description: "Settings is a JSONB object that accepts any of the completion API request parameters."},
-		},
+		}),
 	}
 }
 
 type collectionsRequestQual struct {
-	DatabaseId *string   `json:"database_id"`
-	Search     *string   `json:"search_query"`
-	Query      *[]string `json:"query"`
+	DatabaseId *string `json:"database_id"`
+	Search     *string `json:"search_query"`
+}
+
+var collectionQueryColumns = []queryColumn{
+	{Name: "id", Attribute: "$id", Operators: stringOperators},
+	{Name: "name", Attribute: "name", Operators: stringOperators},
+	{Name: "created_at", Attribute: "$createdAt", Operators: numberOperators},
+	{Name: "updated_at", Attribute: "$updatedAt", Operators: numberOperators},
+	{Name: "document_security", Attribute: "documentSecurity", Operators: boolOperators},
+	{Name: "enabled", Attribute: "enabled", Operators: boolOperators},
 }
 
 type collectionRow struct {
 	Collection appwrite.Collection
 	Search     string
-	Query      []string
 }
 
-func listCollections(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func listCollections(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 
 	conn, err := connect(ctx, d)
 	if err != nil {
@@ -65,15 +75,6 @@ func listCollections(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrate
 		return nil, err
 	}
 
-	queryString := d.EqualsQuals["query"].GetStringValue()
-	var query []string
-	if queryString != "" {
-		err := json.Unmarshal([]byte(queryString), &query)
-		if err != nil {
-			plugin.Logger(ctx).Error("appwrite_collection.listCollections", "connection_error", err)
-			return nil, err
-		}
-	}
 	search := d.EqualsQuals["search_query"].GetStringValue()
 
 	settingsString := d.EqualsQuals["settings"].GetJsonbValue()
@@ -84,33 +85,96 @@ func listCollections(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrate
 			plugin.Logger(ctx).Error("appwrite_collection.listCollections", "unmarshal_error", err)
 			return nil, err
 		}
-		if crQual.Query != nil {
-			query = *crQual.Query
-		}
 		if crQual.Search != nil {
 			search = *crQual.Search
 		}
 	}
 
-	database := appwrite.Database{
-		Client: *conn,
+	databaseId := h.Item.(appwrite.DatabaseObject).Id
+
+	queries := buildQueries(d.Quals, collectionQueryColumns)
+
+	path := apiPath("/databases/%s/collections", databaseId)
+	err = listAll(ctx, d, conn, path, "collections", searchParams(search), queries, func(collection appwrite.Collection) {
+		collection.DatabaseId = databaseId
+		row := collectionRow{
+			Collection: collection,
+			Search:     search,
+		}
+		d.StreamListItem(ctx, row)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_collection.listCollections", "api_error", err)
+		return nil, err
 	}
+	return nil, nil
+}
+
+func getCollection(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
 	databaseId := d.EqualsQuals["database_id"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
 
-	collectionList, err := database.ListCollections(databaseId, search, query)
+	conn, err := connect(ctx, d)
 	if err != nil {
-		plugin.Logger(ctx).Error("appwrite_collection.listCollections", "api_error", err)
+		plugin.Logger(ctx).Error("appwrite_collection.getCollection", "connection_error", err)
 		return nil, err
 	}
-	plugin.Logger(ctx).Trace("appwrite_collection.listCollections", "response", collectionList)
-	collections := *collectionList
-	for _, collection := range collections.Collections {
-		row := collectionRow{
-			Collection: collection,
-			Search:     search,
-			Query:      query,
+
+	collection, err := getOne[appwrite.Collection](ctx, conn, "/databases/%s/collections/%s", databaseId, id)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_collection.getCollection", "api_error", err)
+		return nil, err
+	}
+
+	return collectionRow{Collection: collection}, nil
+}
+
+// listParentCollections is the parent list of tables nested under
+// collections. It streams the collection given by database_id and
+// collection_id, the collections of database_id, or every collection of every
+// database otherwise.
+func listParentCollections(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	databaseId := d.EqualsQuals["database_id"].GetStringValue()
+	collectionId := d.EqualsQuals["collection_id"].GetStringValue()
+	if databaseId != "" && collectionId != "" {
+		d.StreamListItem(ctx, appwrite.Collection{Id: collectionId, DatabaseId: databaseId})
+		return nil, nil
+	}
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_collection.listParentCollections", "connection_error", err)
+		return nil, err
+	}
+
+	databaseIds := []string{databaseId}
+	if databaseId == "" {
+		databaseIds = nil
+		err := listAll(ctx, d, conn, "/databases", "databases", nil, nil, func(database appwrite.DatabaseObject) {
+			databaseIds = append(databaseIds, database.Id)
+		})
+		if err != nil {
+			plugin.Logger(ctx).Error("appwrite_collection.listParentCollections", "api_error", err)
+			return nil, err
+		}
+	}
+
+	// Only list the collection of collection_id in each database, if given
+	var queries []string
+	if collectionId != "" {
+		queries = append(queries, newQuery("equal", "$id", collectionId))
+	}
+
+	for _, databaseId := range databaseIds {
+		path := apiPath("/databases/%s/collections", databaseId)
+		err := listAll(ctx, d, conn, path, "collections", nil, queries, func(collection appwrite.Collection) {
+			collection.DatabaseId = databaseId
+			d.StreamListItem(ctx, collection)
+		})
+		if err != nil {
+			plugin.Logger(ctx).Error("appwrite_collection.listParentCollections", "api_error", err)
+			return nil, err
 		}
-		d.StreamListItem(ctx, row)
 	}
 	return nil, nil
 }
@@ -0,0 +1,141 @@
+package appwrite
+
+import (
+	"context"
+
+	appwrite "github.com/mr-destructive/appwrite-go-sdk"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAppwriteCollectionAttribute(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "appwrite_collection_attribute",
+		Description: "Query attributes of collections in appwrite databases",
+		List: &plugin.ListConfig{
+			ParentHydrate: listParentCollections,
+			Hydrate:       childList(listCollectionAttributes),
+			KeyColumns: append([]*plugin.KeyColumn{
+				{Name: "database_id", Require: plugin.Optional},
+				{Name: "collection_id", Require: plugin.Optional},
+			}, queryKeyColumns(collectionAttributeQueryColumns)...),
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getCollectionAttribute,
+			KeyColumns: plugin.AllColumns([]string{"database_id", "collection_id", "key"}),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			// Result columns
+			{Name: "key", Type: proto.ColumnType_STRING, Transform: transform.FromField("Attribute.Key"), Description: "The key of the attribute."},
+			{Name: "title", Type: proto.ColumnType_STRING, Transform: transform.FromField("Attribute.Key"), Description: "The key of the attribute."},
+			{Name: "type", Type: proto.ColumnType_STRING, Transform: transform.FromField("Attribute.Type"), Description: "The type of the attribute, e.g. string, integer or relationship."},
+			{Name: "status", Type: proto.ColumnType_STRING, Transform: transform.FromField("Attribute.Status"), Description: "The status of the attribute. Possible values are available, processing, deleting, stuck, or failed."},
+			{Name: "error", Type: proto.ColumnType_STRING, Transform: transform.FromField("Attribute.Error"), Description: "The error message if the attribute failed to be created or deleted."},
+			{Name: "required", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Attribute.Required"), Description: "A boolean value for checking if the attribute is required."},
+			{Name: "array", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Attribute.Array"), Description: "A boolean value for checking if the attribute is an array."},
+			{Name: "size", Type: proto.ColumnType_INT, Transform: transform.FromField("Attribute.Size"), Description: "The maximum length of a string attribute."},
+			{Name: "min", Type: proto.ColumnType_DOUBLE, Transform: transform.FromField("Attribute.Min"), Description: "The minimum value of an integer or double attribute."},
+			{Name: "max", Type: proto.ColumnType_DOUBLE, Transform: transform.FromField("Attribute.Max"), Description: "The maximum value of an integer or double attribute."},
+			{Name: "default_value", Type: proto.ColumnType_JSON, Transform: transform.FromField("Attribute.Default"), Description: "The default value of the attribute."},
+			{Name: "format", Type: proto.ColumnType_STRING, Transform: transform.FromField("Attribute.Format"), Description: "The format of a string attribute, e.g. email, enum, ip or url."},
+			{Name: "elements", Type: proto.ColumnType_JSON, Transform: transform.FromField("Attribute.Elements"), Description: "The allowed values(list of strings) of an enum attribute."},
+			{Name: "related_collection", Type: proto.ColumnType_STRING, Transform: transform.FromField("Attribute.RelatedCollection"), Description: "The ID of the related collection of a relationship attribute."},
+			{Name: "relation_type", Type: proto.ColumnType_STRING, Transform: transform.FromField("Attribute.RelationType"), Description: "The type of a relationship attribute. Possible values are oneToOne, oneToMany, manyToOne, or manyToMany."},
+			{Name: "two_way", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Attribute.TwoWay"), Description: "A boolean value for checking if a relationship attribute is two way."},
+			{Name: "two_way_key", Type: proto.ColumnType_STRING, Transform: transform.FromField("Attribute.TwoWayKey"), Description: "The key of the attribute on the related collection of a two way relationship."},
+			{Name: "on_delete", Type: proto.ColumnType_STRING, Transform: transform.FromField("Attribute.OnDelete"), Description: "What happens to related documents when a document is deleted. Possible values are restrict, cascade, or setNull."},
+			{Name: "side", Type: proto.ColumnType_STRING, Transform: transform.FromField("Attribute.Side"), Description: "The side of a relationship attribute, either parent or child."},
+			{Name: "database_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("DatabaseId"), Description: "The ID of the database the collection belongs to."},
+			{Name: "collection_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("CollectionId"), Description: "The ID of the collection the attribute belongs to."},
+		}),
+	}
+}
+
+var collectionAttributeQueryColumns = []queryColumn{
+	{Name: "key", Attribute: "key", Operators: stringOperators},
+	{Name: "type", Attribute: "type", Operators: stringOperators},
+	{Name: "status", Attribute: "status", Operators: stringOperators},
+	{Name: "required", Attribute: "required", Operators: boolOperators},
+	{Name: "array", Attribute: "array", Operators: boolOperators},
+	{Name: "size", Attribute: "size", Operators: numberOperators},
+}
+
+// collectionAttribute is an attribute of a collection. It holds the fields of
+// every attribute type, as the SDK's Attribute only has the common ones.
+type collectionAttribute struct {
+	Key               string      `json:"key"`
+	Type              string      `json:"type"`
+	Status            string      `json:"status"`
+	Error             string      `json:"error"`
+	Required          bool        `json:"required"`
+	Array             bool        `json:"array"`
+	Size              int64       `json:"size"`
+	Min               *float64    `json:"min"`
+	Max               *float64    `json:"max"`
+	Default           interface{} `json:"default"`
+	Format            string      `json:"format"`
+	Elements          []string    `json:"elements"`
+	RelatedCollection string      `json:"relatedCollection"`
+	RelationType      string      `json:"relationType"`
+	TwoWay            bool        `json:"twoWay"`
+	TwoWayKey         string      `json:"twoWayKey"`
+	OnDelete          string      `json:"onDelete"`
+	Side              string      `json:"side"`
+}
+
+type collectionAttributesRow struct {
+	Attribute    collectionAttribute
+	DatabaseId   string
+	CollectionId string
+}
+
+func listCollectionAttributes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_collection_attribute.listCollectionAttributes", "connection_error", err)
+		return nil, err
+	}
+
+	collection := h.Item.(appwrite.Collection)
+
+	queries := buildQueries(d.Quals, collectionAttributeQueryColumns)
+
+	// Attributes have no $id to page with a cursor
+	path := apiPath("/databases/%s/collections/%s/attributes", collection.DatabaseId, collection.Id)
+	err = listAllByOffset(ctx, d, conn, path, "attributes", queries, func(attribute collectionAttribute) bool {
+		row := collectionAttributesRow{
+			Attribute:    attribute,
+			DatabaseId:   collection.DatabaseId,
+			CollectionId: collection.Id,
+		}
+		d.StreamListItem(ctx, row)
+		return true
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_collection_attribute.listCollectionAttributes", "api_error", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getCollectionAttribute(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	databaseId := d.EqualsQuals["database_id"].GetStringValue()
+	collectionId := d.EqualsQuals["collection_id"].GetStringValue()
+	key := d.EqualsQuals["key"].GetStringValue()
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_collection_attribute.getCollectionAttribute", "connection_error", err)
+		return nil, err
+	}
+
+	attribute, err := getOne[collectionAttribute](ctx, conn, "/databases/%s/collections/%s/attributes/%s", databaseId, collectionId, key)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_collection_attribute.getCollectionAttribute", "api_error", err)
+		return nil, err
+	}
+
+	return collectionAttributesRow{Attribute: attribute, DatabaseId: databaseId, CollectionId: collectionId}, nil
+}
@@ -0,0 +1,118 @@
+package appwrite
+
+import (
+	"context"
+
+	appwrite "github.com/mr-destructive/appwrite-go-sdk"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAppwriteCollectionIndex(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "appwrite_collection_index",
+		Description: "Query indexes of collections in appwrite databases",
+		List: &plugin.ListConfig{
+			ParentHydrate: listParentCollections,
+			Hydrate:       childList(listCollectionIndexes),
+			KeyColumns: append([]*plugin.KeyColumn{
+				{Name: "database_id", Require: plugin.Optional},
+				{Name: "collection_id", Require: plugin.Optional},
+			}, queryKeyColumns(collectionIndexQueryColumns)...),
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getCollectionIndex,
+			KeyColumns: plugin.AllColumns([]string{"database_id", "collection_id", "key"}),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			// Result columns
+			{Name: "key", Type: proto.ColumnType_STRING, Transform: transform.FromField("Index.Key"), Description: "The key of the index."},
+			{Name: "title", Type: proto.ColumnType_STRING, Transform: transform.FromField("Index.Key"), Description: "The key of the index."},
+			{Name: "type", Type: proto.ColumnType_STRING, Transform: transform.FromField("Index.Type"), Description: "The type of the index. Possible values are key, unique, or fulltext."},
+			{Name: "status", Type: proto.ColumnType_STRING, Transform: transform.FromField("Index.Status"), Description: "The status of the index. Possible values are available, processing, deleting, stuck, or failed."},
+			{Name: "error", Type: proto.ColumnType_STRING, Transform: transform.FromField("Index.Error"), Description: "The error message if the index failed to be created or deleted."},
+			{Name: "attributes", Type: proto.ColumnType_JSON, Transform: transform.FromField("Index.Attributes"), Description: "The keys(list of strings) of the indexed attributes."},
+			{Name: "orders", Type: proto.ColumnType_JSON, Transform: transform.FromField("Index.Orders"), Description: "The sort orders(list of strings) of the indexed attributes, ASC or DESC."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Index.CreatedAt").Transform(toTimestamp), Description: "Index creation date."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Index.UpdatedAt").Transform(toTimestamp), Description: "Index update date."},
+			{Name: "database_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("DatabaseId"), Description: "The ID of the database the collection belongs to."},
+			{Name: "collection_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("CollectionId"), Description: "The ID of the collection the index belongs to."},
+		}),
+	}
+}
+
+var collectionIndexQueryColumns = []queryColumn{
+	{Name: "key", Attribute: "key", Operators: stringOperators},
+	{Name: "type", Attribute: "type", Operators: stringOperators},
+	{Name: "status", Attribute: "status", Operators: stringOperators},
+}
+
+// collectionIndex is an index of a collection, which the SDK only has as a
+// JSON blob.
+type collectionIndex struct {
+	Key        string   `json:"key"`
+	Type       string   `json:"type"`
+	Status     string   `json:"status"`
+	Error      string   `json:"error"`
+	Attributes []string `json:"attributes"`
+	Orders     []string `json:"orders"`
+	CreatedAt  string   `json:"$createdAt"`
+	UpdatedAt  string   `json:"$updatedAt"`
+}
+
+type collectionIndexesRow struct {
+	Index        collectionIndex
+	DatabaseId   string
+	CollectionId string
+}
+
+func listCollectionIndexes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_collection_index.listCollectionIndexes", "connection_error", err)
+		return nil, err
+	}
+
+	collection := h.Item.(appwrite.Collection)
+
+	queries := buildQueries(d.Quals, collectionIndexQueryColumns)
+
+	// Indexes have no $id to page with a cursor
+	path := apiPath("/databases/%s/collections/%s/indexes", collection.DatabaseId, collection.Id)
+	err = listAllByOffset(ctx, d, conn, path, "indexes", queries, func(index collectionIndex) bool {
+		row := collectionIndexesRow{
+			Index:        index,
+			DatabaseId:   collection.DatabaseId,
+			CollectionId: collection.Id,
+		}
+		d.StreamListItem(ctx, row)
+		return true
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_collection_index.listCollectionIndexes", "api_error", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getCollectionIndex(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	databaseId := d.EqualsQuals["database_id"].GetStringValue()
+	collectionId := d.EqualsQuals["collection_id"].GetStringValue()
+	key := d.EqualsQuals["key"].GetStringValue()
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_collection_index.getCollectionIndex", "connection_error", err)
+		return nil, err
+	}
+
+	index, err := getOne[collectionIndex](ctx, conn, "/databases/%s/collections/%s/indexes/%s", databaseId, collectionId, key)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_collection_index.getCollectionIndex", "api_error", err)
+		return nil, err
+	}
+
+	return collectionIndexesRow{Index: index, DatabaseId: databaseId, CollectionId: collectionId}, nil
+}
@@ -16,37 +16,44 @@ func tableAppwriteDatabase(ctx context.Context) *plugin.Table {
 		Description: "Query database meta information in an appwrite project",
 		List: &plugin.ListConfig{
 			Hydrate: listDatabases,
-			KeyColumns: []*plugin.KeyColumn{
+			KeyColumns: append([]*plugin.KeyColumn{
 				{Name: "search_query", Require: plugin.Optional},
-				{Name: "query", Require: plugin.Optional},
 				{Name: "settings", Require: plugin.Optional},
-			},
+			}, queryKeyColumns(databaseQueryColumns)...),
 		},
-		Columns: []*plugin.Column{
+		Get: &plugin.GetConfig{
+			Hydrate:    getDatabase,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		Columns: commonColumns([]*plugin.Column{
 			// Result columns
 			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Database.Id"), Description: "The unique ID for the database."},
 			{Name: "title", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "The Name or ID of the database."},
 			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Database.Name"), Description: "The Name of the database."},
-			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.FromField("Database.CreatedAt"), Description: "Database creation date in ISO 8601 format."},
-			{Name: "updated_at", Type: proto.ColumnType_STRING, Transform: transform.FromField("Database.UpdatedAt"), Description: "Database updation date in ISO 8601 format."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Database.CreatedAt").Transform(toTimestamp), Description: "Database creation date."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Database.UpdatedAt").Transform(toTimestamp), Description: "Database updation date."},
 
 			// Input Columns
 			{Name: "search_query", Type: proto.ColumnType_STRING, Transform: transform.FromField("Search"), Description: "The string for filtering the results from the request."},
-			{Name: "query", Type: proto.ColumnType_STRING, Transform: transform.FromField("Query"), Description: "A string of query type as filter for the request."},
 			{Name: "settings", Type: proto.ColumnType_JSON, Transform: transform.FromQual("settings"), Description: "Settings is a JSONB object that accepts any of the completion API request parameters."},
-		},
+		}),
 	}
 }
 
 type databasessRequestQual struct {
-	Search *string   `json:"search_query"`
-	Query  *[]string `json:"query"`
+	Search *string `json:"search_query"`
+}
+
+var databaseQueryColumns = []queryColumn{
+	{Name: "id", Attribute: "$id", Operators: stringOperators},
+	{Name: "name", Attribute: "name", Operators: stringOperators},
+	{Name: "created_at", Attribute: "$createdAt", Operators: numberOperators},
+	{Name: "updated_at", Attribute: "$updatedAt", Operators: numberOperators},
 }
 
 type databasesRow struct {
 	Database appwrite.DatabaseObject
 	Search   string
-	Query    []string
 }
 
 func listDatabases(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
@@ -56,18 +63,6 @@ func listDatabases(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDa
 		plugin.Logger(ctx).Error("appwrite_database.listDatabases", "connection_error", err)
 		return nil, err
 	}
-	queryString := d.EqualsQuals["query"].GetStringValue()
-	var query []string
-	if queryString != "" {
-		err := json.Unmarshal([]byte(queryString), &query)
-		if err != nil {
-			plugin.Logger(ctx).Error("appwrite_database.listDatabases", "connection_error", err)
-			return nil, err
-		}
-	}
-	if len(query) == 0 {
-		query = []string{}
-	}
 	search := d.EqualsQuals["search_query"].GetStringValue()
 
 	settingsString := d.EqualsQuals["settings"].GetJsonbValue()
@@ -78,32 +73,63 @@ func listDatabases(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDa
 			plugin.Logger(ctx).Error("appwrite_database.listDatabases", "unmarshal_error", err)
 			return nil, err
 		}
-		if crQual.Query != nil {
-			query = *crQual.Query
-		}
 		if crQual.Search != nil {
 			search = *crQual.Search
 		}
 	}
 
-	database := appwrite.Database{
-		Client: *conn,
-	}
+	queries := buildQueries(d.Quals, databaseQueryColumns)
 
-	databasesList, err := database.ListDatabases(search, query)
-	if err != nil {
-		plugin.Logger(ctx).Error("appwrite_database.listDatabases", "api_error", err)
-		return nil, err
-	}
-	plugin.Logger(ctx).Trace("appwrite_database.listDatabases", "response", databasesList)
-	databases := *databasesList
-	for _, database := range databases.Databases {
+	err = listAll(ctx, d, conn, "/databases", "databases", searchParams(search), queries, func(database appwrite.DatabaseObject) {
 		row := databasesRow{
 			Database: database,
 			Search:   search,
-			Query:    query,
 		}
 		d.StreamListItem(ctx, row)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_database.listDatabases", "api_error", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getDatabase(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQuals["id"].GetStringValue()
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_database.getDatabase", "connection_error", err)
+		return nil, err
+	}
+
+	database, err := getOne[appwrite.DatabaseObject](ctx, conn, "/databases/%s", id)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_database.getDatabase", "api_error", err)
+		return nil, err
+	}
+
+	return databasesRow{Database: database}, nil
+}
+
+func listParentDatabases(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	if databaseId := d.EqualsQuals["database_id"].GetStringValue(); databaseId != "" {
+		d.StreamListItem(ctx, appwrite.DatabaseObject{Id: databaseId})
+		return nil, nil
+	}
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_database.listParentDatabases", "connection_error", err)
+		return nil, err
+	}
+
+	err = listAll(ctx, d, conn, "/databases", "databases", nil, nil, func(database appwrite.DatabaseObject) {
+		d.StreamListItem(ctx, database)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_database.listParentDatabases", "api_error", err)
+		return nil, err
 	}
 	return nil, nil
 }
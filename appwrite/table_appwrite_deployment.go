@@ -15,18 +15,23 @@ func tableAppwriteDeployment(ctx context.Context) *plugin.Table {
 		Name:        "appwrite_deployment",
 		Description: "Query deployment information of a function for an appwrite project",
 		List: &plugin.ListConfig{
-			Hydrate: listDeployments,
-			KeyColumns: []*plugin.KeyColumn{
+			ParentHydrate: listParentFunctions,
+			Hydrate:       childList(listDeployments),
+			KeyColumns: append([]*plugin.KeyColumn{
+				{Name: "function_id", Require: plugin.Optional},
 				{Name: "search_query", Require: plugin.Optional},
-				{Name: "query", Require: plugin.Optional},
 				{Name: "settings", Require: plugin.Optional},
-			},
+			}, queryKeyColumns(deploymentQueryColumns)...),
 		},
-		Columns: []*plugin.Column{
+		Get: &plugin.GetConfig{
+			Hydrate:    getDeployment,
+			KeyColumns: plugin.AllColumns([]string{"function_id", "id"}),
+		},
+		Columns: commonColumns([]*plugin.Column{
 			// Result columns
 			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Deployment.Id"), Description: "The unique ID for the deployment."},
-			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.FromField("Deployment.CreatedAt"), Description: "Deployment creation date in ISO 8601 format."},
-			{Name: "updated_at", Type: proto.ColumnType_STRING, Transform: transform.FromField("Deployment.UpdatedAt"), Description: "Deployment updation date in ISO 8601 format."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Deployment.CreatedAt").Transform(toTimestamp), Description: "Deployment creation date."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Deployment.UpdatedAt").Transform(toTimestamp), Description: "Deployment updation date."},
 			{Name: "resource_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Deployment.ResourceId"), Description: "The unique ID for the resource in the deployment."},
 			{Name: "resource_type", Type: proto.ColumnType_STRING, Transform: transform.FromField("Deployment.ResourceType"), Description: "The type of resource in the deployment."},
 			{Name: "entry_point", Type: proto.ColumnType_STRING, Transform: transform.FromField("Deployment.EntryPoint"), Description: "The entrypoint file to use to execute the deployment code."},
@@ -39,47 +44,43 @@ func tableAppwriteDeployment(ctx context.Context) *plugin.Table {
 			{Name: "build_time", Type: proto.ColumnType_STRING, Transform: transform.FromField("Deployment.BuildTime"), Description: "The time taken for the current build in seconds."},
 
 			// Input Columns
-			{Name: "function_id", Type: proto.ColumnType_STRING, Transform: transform.FromQual("function_id"), Description: "The unique ID for the function to fetch the deployments from."},
+			{Name: "function_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FunctionId"), Description: "The unique ID for the function to fetch the deployments from."},
 			{Name: "search_query", Type: proto.ColumnType_STRING, Transform: transform.FromField("Search"), Description: "The string to filter the results from the request."},
-			{Name: "query", Type: proto.ColumnType_STRING, Transform: transform.FromField("Query"), Description: "A string of query type as filter for the request."},
 			{Name: "settings", Type: proto.ColumnType_JSON, Transform: transform.FromQual("settings"), Description: "Settings is a JSONB object that accepts any of the completion API request parameters."},
-		},
+		}),
 	}
 }
 
 type deploymentsRequestQual struct {
-	FunctionId *string   `json:"function_id"`
-	Search     *string   `json:"search_query"`
-	Query      *[]string `json:"query"`
+	FunctionId *string `json:"function_id"`
+	Search     *string `json:"search_query"`
+}
+
+var deploymentQueryColumns = []queryColumn{
+	{Name: "id", Attribute: "$id", Operators: stringOperators},
+	{Name: "created_at", Attribute: "$createdAt", Operators: numberOperators},
+	{Name: "updated_at", Attribute: "$updatedAt", Operators: numberOperators},
+	{Name: "entry_point", Attribute: "entrypoint", Operators: stringOperators},
+	{Name: "size", Attribute: "size", Operators: numberOperators},
+	{Name: "build_id", Attribute: "buildId", Operators: stringOperators},
+	{Name: "activate", Attribute: "activate", Operators: boolOperators},
 }
 
 type deploymentsRow struct {
 	Deployment appwrite.DeploymentObject
+	FunctionId string
 	Search     string
-	Query      []string
 }
 
-func listDeployments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func listDeployments(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 
 	conn, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("appwrite_deployment.listDeployments", "connection_error", err)
 		return nil, err
 	}
-	queryString := d.EqualsQuals["query"].GetStringValue()
-	var query []string
-	if queryString != "" {
-		err := json.Unmarshal([]byte(queryString), &query)
-		if err != nil {
-			plugin.Logger(ctx).Error("appwrite_deployment.listDeployments", "connection_error", err)
-			return nil, err
-		}
-	}
-	if len(query) == 0 {
-		query = []string{}
-	}
 
-	function_id := d.EqualsQuals["function_id"].GetStringValue()
+	functionId := h.Item.(appwrite.FunctionObject).Id
 	search := d.EqualsQuals["search_query"].GetStringValue()
 
 	settingsString := d.EqualsQuals["settings"].GetJsonbValue()
@@ -90,31 +91,44 @@ func listDeployments(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrate
 			plugin.Logger(ctx).Error("appwrite_deployment.listDeployments", "unmarshal_error", err)
 			return nil, err
 		}
-		if crQual.Query != nil {
-			query = *crQual.Query
-		}
 		if crQual.Search != nil {
 			search = *crQual.Search
 		}
 	}
 
-	functions := appwrite.Function{
-		Client: *conn,
-	}
-	deploymentsList, err := functions.ListDeployments(function_id, "", query)
-	if err != nil {
-		plugin.Logger(ctx).Error("appwrite_deployment.listDeployments", "api_error", err)
-		return nil, err
-	}
-	plugin.Logger(ctx).Trace("appwrite_deployment.listDeployments", "response", deploymentsList)
-	deployments := *deploymentsList
-	for _, deployment := range deployments.Deployments {
+	queries := buildQueries(d.Quals, deploymentQueryColumns)
+
+	path := apiPath("/functions/%s/deployments", functionId)
+	err = listAll(ctx, d, conn, path, "deployments", searchParams(search), queries, func(deployment appwrite.DeploymentObject) {
 		row := deploymentsRow{
 			Deployment: deployment,
+			FunctionId: functionId,
 			Search:     search,
-			Query:      query,
 		}
 		d.StreamListItem(ctx, row)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_deployment.listDeployments", "api_error", err)
+		return nil, err
 	}
 	return nil, nil
 }
+
+func getDeployment(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	functionId := d.EqualsQuals["function_id"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_deployment.getDeployment", "connection_error", err)
+		return nil, err
+	}
+
+	deployment, err := getOne[appwrite.DeploymentObject](ctx, conn, "/functions/%s/deployments/%s", functionId, id)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_deployment.getDeployment", "api_error", err)
+		return nil, err
+	}
+
+	return deploymentsRow{Deployment: deployment, FunctionId: functionId}, nil
+}
@@ -0,0 +1,224 @@
+package appwrite
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"unicode"
+
+	appwrite "github.com/mr-destructive/appwrite-go-sdk"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// documentTableDefinitions builds an appwrite_doc_<database>_<collection>
+// table for every collection matched by the connection's include_collections
+// and exclude_collections globs.
+func documentTableDefinitions(ctx context.Context, connection *plugin.Connection) (map[string]*plugin.Table, error) {
+	appwriteConfig := GetConfig(connection)
+
+	// Collections are matched as <database_id>/<collection_id>
+	include := appwriteConfig.IncludeCollections
+	if include == nil {
+		include = []string{"*/*"}
+	}
+	for _, pattern := range append(include, appwriteConfig.ExcludeCollections...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid collection pattern %q: %w", pattern, err)
+		}
+	}
+	if len(include) == 0 {
+		return nil, nil
+	}
+
+	conn, err := newClient(appwriteConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	var databases []appwrite.DatabaseObject
+	err = listAll(ctx, nil, conn, "/databases", "databases", nil, nil, func(database appwrite.DatabaseObject) {
+		databases = append(databases, database)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tables := map[string]*plugin.Table{}
+	for _, database := range databases {
+		collectionsPath := apiPath("/databases/%s/collections", database.Id)
+		err := listAll(ctx, nil, conn, collectionsPath, "collections", nil, nil, func(collection appwrite.Collection) {
+			key := database.Id + "/" + collection.Id
+			if !matchesAny(key, include) || matchesAny(key, appwriteConfig.ExcludeCollections) {
+				return
+			}
+			name := "appwrite_doc_" + sqlName(database.Id) + "_" + sqlName(collection.Id)
+			// Different IDs can map to the same name, e.g. my-db and my_db
+			if _, ok := tables[name]; ok {
+				plugin.Logger(ctx).Warn("documentTableDefinitions", "duplicate_table", name, "skipped_collection", key)
+				return
+			}
+			tables[name] = tableAppwriteDoc(ctx, name, database.Id, collection)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return tables, nil
+}
+
+func tableAppwriteDoc(ctx context.Context, name string, databaseId string, collection appwrite.Collection) *plugin.Table {
+	columns := []*plugin.Column{
+		{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Document.Id"), Description: "The unique ID for the document."},
+		{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Document.CreatedAt").Transform(toTimestamp), Description: "Document creation date."},
+		{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Document.UpdatedAt").Transform(toTimestamp), Description: "Document updation date."},
+		{Name: "permissions", Type: proto.ColumnType_JSON, Transform: transform.FromField("Document.Permissions"), Description: "The permission settings(list of strings) for the document."},
+		{Name: "database_id", Type: proto.ColumnType_STRING, Transform: transform.FromConstant(databaseId), Description: "The ID of the database the document belongs to."},
+		{Name: "collection_id", Type: proto.ColumnType_STRING, Transform: transform.FromConstant(collection.Id), Description: "The ID of the collection the document belongs to."},
+	}
+
+	queryColumns := append([]queryColumn{}, documentQueryColumns...)
+
+	// project_id is added by commonColumns
+	used := map[string]bool{"project_id": true}
+	for _, column := range columns {
+		used[column.Name] = true
+	}
+	for _, attribute := range collection.Attributes {
+		columnName := sqlName(attribute.Key)
+		if used[columnName] {
+			plugin.Logger(ctx).Warn("tableAppwriteDoc", "table", name, "duplicate_column", columnName, "attribute", attribute.Key)
+			continue
+		}
+		used[columnName] = true
+		columnType := attributeColumnType(attribute)
+		columnTransform := transform.FromP(documentFieldValue, attribute.Key)
+		if columnType == proto.ColumnType_TIMESTAMP {
+			columnTransform = columnTransform.Transform(toTimestamp)
+		}
+		columns = append(columns, &plugin.Column{
+			Name:        columnName,
+			Type:        columnType,
+			Transform:   columnTransform,
+			Description: fmt.Sprintf("The %s attribute of the document.", attribute.Key),
+		})
+		if operators := attributeOperators(attribute); operators != nil {
+			queryColumns = append(queryColumns, queryColumn{Name: columnName, Attribute: attribute.Key, Operators: operators})
+		}
+	}
+
+	return &plugin.Table{
+		Name:        name,
+		Description: fmt.Sprintf("Query documents of the %s collection in the %s appwrite database.", collection.Name, databaseId),
+		List: &plugin.ListConfig{
+			Hydrate:    listDocCollection(databaseId, collection.Id, queryColumns),
+			KeyColumns: queryKeyColumns(queryColumns),
+		},
+		Columns: commonColumns(columns),
+	}
+}
+
+// attributeColumnType maps an Appwrite attribute type to a Steampipe column
+// type. Arrays and relationships are returned as JSON.
+func attributeColumnType(attribute appwrite.Attribute) proto.ColumnType {
+	if attribute.Array {
+		return proto.ColumnType_JSON
+	}
+	switch attribute.Type {
+	case "integer":
+		return proto.ColumnType_INT
+	case "double":
+		return proto.ColumnType_DOUBLE
+	case "boolean":
+		return proto.ColumnType_BOOL
+	case "datetime":
+		return proto.ColumnType_TIMESTAMP
+	case "relationship":
+		return proto.ColumnType_JSON
+	default:
+		// string, email, enum, ip and url
+		return proto.ColumnType_STRING
+	}
+}
+
+// attributeOperators returns the qual operators Appwrite can filter the
+// attribute on, or nil if its quals can't be pushed down.
+func attributeOperators(attribute appwrite.Attribute) []string {
+	if attribute.Array {
+		return nil
+	}
+	switch attribute.Type {
+	case "integer", "double", "datetime":
+		return numberOperators
+	case "boolean":
+		return boolOperators
+	case "relationship":
+		return nil
+	default:
+		return stringOperators
+	}
+}
+
+func listDocCollection(databaseId string, collectionId string, queryColumns []queryColumn) plugin.HydrateFunc {
+	return func(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+		conn, err := connect(ctx, d)
+		if err != nil {
+			plugin.Logger(ctx).Error("appwrite_doc.listDocCollection", "connection_error", err)
+			return nil, err
+		}
+
+		queries := buildQueries(d.Quals, queryColumns)
+
+		documentsPath := apiPath("/databases/%s/collections/%s/documents", databaseId, collectionId)
+		err = listAll(ctx, d, conn, documentsPath, "documents", nil, queries, func(document map[string]interface{}) {
+			row := documentRow{
+				Document: newDocument(document),
+			}
+			d.StreamListItem(ctx, row)
+		})
+		if err != nil {
+			plugin.Logger(ctx).Error("appwrite_doc.listDocCollection", "api_error", err)
+			return nil, err
+		}
+		return nil, nil
+	}
+}
+
+func documentFieldValue(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	row := d.HydrateItem.(documentRow)
+	return row.Document.Fields[d.Param.(string)], nil
+}
+
+// matchesAny reports whether name matches any of the glob patterns.
+func matchesAny(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// sqlName converts an Appwrite ID or attribute key into a snake_case SQL
+// identifier, e.g. firstName becomes first_name and my-db becomes my_db.
+func sqlName(s string) string {
+	var b strings.Builder
+	runes := []rune(s)
+	for i, r := range runes {
+		switch {
+		case unicode.IsUpper(r):
+			if i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])) {
+				b.WriteRune('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	return b.String()
+}
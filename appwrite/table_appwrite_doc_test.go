@@ -0,0 +1,105 @@
+package appwrite
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	appwrite "github.com/mr-destructive/appwrite-go-sdk"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func TestDocumentTableDefinitionsSkipsDuplicateNames(t *testing.T) {
+	clearEnv(t)
+	responses := map[string]string{
+		"/v1/databases":                   `{"databases": [{"$id": "my-db"}, {"$id": "my_db"}]}`,
+		"/v1/databases/my-db/collections": `{"collections": [{"$id": "tasks", "name": "first"}]}`,
+		"/v1/databases/my_db/collections": `{"collections": [{"$id": "tasks", "name": "second"}]}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(responses[r.URL.Path]))
+	}))
+	t.Cleanup(server.Close)
+
+	endpoint, projectID, secretKey := server.URL+"/v1", "project", "key"
+	connection := &plugin.Connection{Name: "appwrite", Config: appwriteConfig{Endpoint: &endpoint, ProjectID: &projectID, SecretKey: &secretKey}}
+	tables, err := documentTableDefinitions(testContext(), connection)
+	if err != nil {
+		t.Fatalf("documentTableDefinitions: %v", err)
+	}
+
+	if len(tables) != 1 {
+		t.Fatalf("tables = %v, want only appwrite_doc_my_db_tasks", tables)
+	}
+	table, ok := tables["appwrite_doc_my_db_tasks"]
+	if !ok {
+		t.Fatalf("tables = %v, want appwrite_doc_my_db_tasks", tables)
+	}
+	// The first collection listed keeps the name
+	if want := "Query documents of the first collection in the my-db appwrite database."; table.Description != want {
+		t.Errorf("description = %q, want %q", table.Description, want)
+	}
+}
+
+func TestTableAppwriteDocConvertsDatetimeAttributes(t *testing.T) {
+	var collection appwrite.Collection
+	if err := json.Unmarshal([]byte(`{"$id": "tasks", "attributes": [{"key": "dueAt", "type": "datetime"}]}`), &collection); err != nil {
+		t.Fatal(err)
+	}
+
+	table := tableAppwriteDoc(testContext(), "appwrite_doc_db_tasks", "db", collection)
+	var dueAt *plugin.Column
+	for _, column := range table.Columns {
+		if column.Name == "due_at" {
+			dueAt = column
+		}
+	}
+	if dueAt == nil {
+		t.Fatal("no due_at column")
+	}
+
+	for raw, want := range map[string]interface{}{
+		"2024-03-01T12:30:00.000+00:00": time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC),
+		"":                              nil,
+	} {
+		row := documentRow{Document: newDocument(map[string]interface{}{"dueAt": raw})}
+		value, err := dueAt.Transform.Execute(testContext(), &transform.TransformData{HydrateItem: row, ColumnName: "due_at"})
+		if err != nil {
+			t.Fatalf("transform %q: %v", raw, err)
+		}
+		if got, ok := value.(time.Time); ok {
+			if !got.Equal(want.(time.Time)) {
+				t.Errorf("due_at for %q = %v, want %v", raw, got, want)
+			}
+		} else if value != want {
+			t.Errorf("due_at for %q = %#v, want %#v", raw, value, want)
+		}
+	}
+}
+
+func TestTableAppwriteDocSkipsReservedColumns(t *testing.T) {
+	var collection appwrite.Collection
+	if err := json.Unmarshal([]byte(`{"$id": "tasks", "attributes": [{"key": "projectId", "type": "string"}, {"key": "id", "type": "string"}, {"key": "title", "type": "string"}]}`), &collection); err != nil {
+		t.Fatal(err)
+	}
+
+	table := tableAppwriteDoc(testContext(), "appwrite_doc_db_tasks", "db", collection)
+	names := map[string]int{}
+	for _, column := range table.Columns {
+		names[column.Name]++
+	}
+	for _, name := range []string{"id", "project_id", "title"} {
+		if names[name] != 1 {
+			t.Errorf("%d %s columns, want 1", names[name], name)
+		}
+	}
+	for _, column := range table.Columns {
+		if column.Name == "project_id" && column.Hydrate == nil {
+			t.Error("project_id column is the projectId attribute, want the connection's project")
+		}
+	}
+}
@@ -3,6 +3,7 @@ package appwrite
 import (
 	"context"
 	"encoding/json"
+	"strings"
 
 	appwrite "github.com/mr-destructive/appwrite-go-sdk"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
@@ -15,30 +16,35 @@ func tableAppwriteDocument(ctx context.Context) *plugin.Table {
 		Name:        "appwrite_document",
 		Description: "Query documents of a collection from an appwrite database",
 		List: &plugin.ListConfig{
-			Hydrate: listDocuments,
-			KeyColumns: []*plugin.KeyColumn{
+			ParentHydrate: listParentCollections,
+			Hydrate:       childList(listDocuments),
+			KeyColumns: append([]*plugin.KeyColumn{
 				{Name: "database_id", Require: plugin.Optional},
 				{Name: "collection_id", Require: plugin.Optional},
 				{Name: "search_query", Require: plugin.Optional},
 				{Name: "settings", Require: plugin.Optional},
-			},
+			}, queryKeyColumns(documentQueryColumns)...),
 		},
-		Columns: []*plugin.Column{
+		Get: &plugin.GetConfig{
+			Hydrate:    getDocument,
+			KeyColumns: plugin.AllColumns([]string{"database_id", "collection_id", "id"}),
+		},
+		Columns: commonColumns([]*plugin.Column{
 			// Result columns
 			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Document.Id"), Description: "The unique ID for the document."},
 			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Document.Name"), Description: "The Name of the document."},
 			{Name: "title", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "The Name or ID of the document."},
 			{Name: "fields", Type: proto.ColumnType_JSON, Transform: transform.FromField("Document.Fields"), Description: "The fields in the document."},
-			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.FromField("Document.CreatedAt"), Description: "created at"},
-			{Name: "updated_at", Type: proto.ColumnType_STRING, Transform: transform.FromField("Document.UpdatedAt"), Description: "updated at"},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Document.CreatedAt").Transform(toTimestamp), Description: "Document creation date."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Document.UpdatedAt").Transform(toTimestamp), Description: "Document update date."},
 			{Name: "permissions", Type: proto.ColumnType_JSON, Transform: transform.FromField("Document.Permissions"), Description: "permissions"},
 
 			// Input Columns
-			{Name: "database_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("DatabaseId"), Description: "DatabaseId"},
-			{Name: "collection_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("CollectionId"), Description: "CollectionId"},
+			{Name: "database_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Document.DatabaseId"), Description: "DatabaseId"},
+			{Name: "collection_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Document.CollectionId"), Description: "CollectionId"},
 			{Name: "search_query", Type: proto.ColumnType_STRING, Transform: transform.FromField("Search"), Description: "The string to filter the results from the request."},
 			{Name: "settings", Type: proto.ColumnType_JSON, Transform: transform.FromQual("settings"), Description: "Settings is a JSONB object that accepts any of the completion API request parameters."},
-		},
+		}),
 	}
 }
 
@@ -48,12 +54,18 @@ type documentsRequestQual struct {
 	Search       *string `json:"search_query"`
 }
 
+var documentQueryColumns = []queryColumn{
+	{Name: "id", Attribute: "$id", Operators: stringOperators},
+	{Name: "created_at", Attribute: "$createdAt", Operators: numberOperators},
+	{Name: "updated_at", Attribute: "$updatedAt", Operators: numberOperators},
+}
+
 type documentRow struct {
 	Document appwrite.Document
 	Search   string
 }
 
-func listDocuments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func listDocuments(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 
 	conn, err := connect(ctx, d)
 	if err != nil {
@@ -71,26 +83,76 @@ func listDocuments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDa
 		}
 	}
 
-	database := appwrite.Database{
-		Client: *conn,
-	}
-	databaseId := d.EqualsQuals["database_id"].GetStringValue()
-	collectionId := d.EqualsQuals["collection_id"].GetStringValue()
+	collection := h.Item.(appwrite.Collection)
 	search := d.EqualsQuals["search_query"].GetStringValue()
 
-	documentList, err := database.ListDocuments(databaseId, collectionId, []interface{}{}, 0, 0, "", "", "", search, 0, 0)
-	if err != nil {
-		plugin.Logger(ctx).Error("appwrite_document.listDocuments", "api_error", err)
-		return nil, err
-	}
-	plugin.Logger(ctx).Trace("appwrite_document.listDocuments", "response", documentList)
-	documents := *documentList
-	for _, document := range documents.Documents {
+	queries := buildQueries(d.Quals, documentQueryColumns)
+
+	path := apiPath("/databases/%s/collections/%s/documents", collection.DatabaseId, collection.Id)
+	err = listAll(ctx, d, conn, path, "documents", searchParams(search), queries, func(document map[string]interface{}) {
 		row := documentRow{
-			Document: document,
+			Document: newDocument(document),
 			Search:   search,
 		}
 		d.StreamListItem(ctx, row)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_document.listDocuments", "api_error", err)
+		return nil, err
 	}
 	return nil, nil
 }
+
+// newDocument splits a raw document into its system fields, which Appwrite
+// prefixes with $, and the collection attributes stored in Fields.
+func newDocument(raw map[string]interface{}) appwrite.Document {
+	document := appwrite.Document{
+		Fields: map[string]interface{}{},
+	}
+	for key, value := range raw {
+		switch key {
+		case "$id":
+			document.Id, _ = value.(string)
+		case "$createdAt":
+			document.CreatedAt, _ = value.(string)
+		case "$updatedAt":
+			document.UpdatedAt, _ = value.(string)
+		case "$databaseId":
+			document.DatabaseId, _ = value.(string)
+		case "$collectionId":
+			document.CollectionId, _ = value.(string)
+		case "$permissions":
+			permissions, _ := value.([]interface{})
+			for _, p := range permissions {
+				if s, ok := p.(string); ok {
+					document.Permissions = append(document.Permissions, s)
+				}
+			}
+		default:
+			if !strings.HasPrefix(key, "$") {
+				document.Fields[key] = value
+			}
+		}
+	}
+	return document
+}
+
+func getDocument(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	databaseId := d.EqualsQuals["database_id"].GetStringValue()
+	collectionId := d.EqualsQuals["collection_id"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_document.getDocument", "connection_error", err)
+		return nil, err
+	}
+
+	document, err := getOne[map[string]interface{}](ctx, conn, "/databases/%s/collections/%s/documents/%s", databaseId, collectionId, id)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_document.getDocument", "api_error", err)
+		return nil, err
+	}
+
+	return documentRow{Document: newDocument(document)}, nil
+}
@@ -15,18 +15,23 @@ func tableAppwriteExecution(ctx context.Context) *plugin.Table {
 		Name:        "appwrite_execution",
 		Description: "Query executions meta information of a function deployment in an appwrite project",
 		List: &plugin.ListConfig{
-			Hydrate: listExecutions,
-			KeyColumns: []*plugin.KeyColumn{
+			ParentHydrate: listParentFunctions,
+			Hydrate:       childList(listExecutions),
+			KeyColumns: append([]*plugin.KeyColumn{
+				{Name: "function_id", Require: plugin.Optional},
 				{Name: "search_query", Require: plugin.Optional},
-				{Name: "query", Require: plugin.Optional},
 				{Name: "settings", Require: plugin.Optional},
-			},
+			}, queryKeyColumns(executionQueryColumns)...),
 		},
-		Columns: []*plugin.Column{
+		Get: &plugin.GetConfig{
+			Hydrate:    getExecution,
+			KeyColumns: plugin.AllColumns([]string{"function_id", "id"}),
+		},
+		Columns: commonColumns([]*plugin.Column{
 			// Result columns
 			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Execution.Id"), Description: "The unique ID for the execution of the function."},
-			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.FromField("Execution.CreatedAt"), Description: "Execution creation date in ISO 8601 format."},
-			{Name: "updated_at", Type: proto.ColumnType_STRING, Transform: transform.FromField("Execution.UpdatedAt"), Description: "Execution updation date in ISO 8601 format"},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Execution.CreatedAt").Transform(toTimestamp), Description: "Execution creation date."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Execution.UpdatedAt").Transform(toTimestamp), Description: "Execution updation date."},
 			{Name: "permissions", Type: proto.ColumnType_JSON, Transform: transform.FromField("Execution.Permissions"), Description: "The roles(permission settings) for the execution."},
 			{Name: "trigger", Type: proto.ColumnType_STRING, Transform: transform.FromField("Execution.Trigger"), Description: "The trigger that caused the function to execute. Possible values can be: http, schedule, or event."},
 			{Name: "status", Type: proto.ColumnType_STRING, Transform: transform.FromField("Execution.Status"), Description: "The status of the function execution. Possible values can be: waiting, processing, completed, or failed."},
@@ -37,45 +42,40 @@ func tableAppwriteExecution(ctx context.Context) *plugin.Table {
 			{Name: "duration", Type: proto.ColumnType_STRING, Transform: transform.FromField("Execution.Duration"), Description: "The duration of the execution script in seconds."},
 
 			// Input Columns
-			{Name: "function_id", Type: proto.ColumnType_STRING, Transform: transform.FromQual("function_id"), Description: "The unique ID of function to fetch the executions from."},
+			{Name: "function_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FunctionId"), Description: "The unique ID of function to fetch the executions from."},
 			{Name: "search_query", Type: proto.ColumnType_STRING, Transform: transform.FromField("Search"), Description: "The string to filter the results from the request."},
-			{Name: "query", Type: proto.ColumnType_STRING, Transform: transform.FromField("Query"), Description: "The string of query type to filter the results from the request."},
 			{Name: "settings", Type: proto.ColumnType_JSON, Transform: transform.FromQual("settings"), Description: "Settings is a JSONB object that accepts any of the completion API request parameters."},
-		},
+		}),
 	}
 }
 
 type executionssRequestQual struct {
-	Search *string   `json:"search_query"`
-	Query  *[]string `json:"query"`
+	Search *string `json:"search_query"`
+}
+
+var executionQueryColumns = []queryColumn{
+	{Name: "id", Attribute: "$id", Operators: stringOperators},
+	{Name: "created_at", Attribute: "$createdAt", Operators: numberOperators},
+	{Name: "updated_at", Attribute: "$updatedAt", Operators: numberOperators},
+	{Name: "trigger", Attribute: "trigger", Operators: stringOperators},
+	{Name: "status", Attribute: "status", Operators: stringOperators},
+	{Name: "status_code", Attribute: "statusCode", Operators: numberOperators},
 }
 
 type executionsRow struct {
-	Execution appwrite.ExecutionObject
-	Search    string
-	Query     []string
+	Execution  appwrite.ExecutionObject
+	FunctionId string
+	Search     string
 }
 
-func listExecutions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func listExecutions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 
 	conn, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("appwrite_execution.listExecutions", "connection_error", err)
 		return nil, err
 	}
-	queryString := d.EqualsQuals["query"].GetStringValue()
-	var query []string
-	if queryString != "" {
-		err := json.Unmarshal([]byte(queryString), &query)
-		if err != nil {
-			plugin.Logger(ctx).Error("appwrite_execution.listExecutions", "connection_error", err)
-			return nil, err
-		}
-	}
-	if len(query) == 0 {
-		query = []string{}
-	}
-	function_id := d.EqualsQuals["function_id"].GetStringValue()
+	functionId := h.Item.(appwrite.FunctionObject).Id
 	search := d.EqualsQuals["search_query"].GetStringValue()
 
 	settingsString := d.EqualsQuals["settings"].GetJsonbValue()
@@ -88,24 +88,39 @@ func listExecutions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateD
 		}
 	}
 
-	functions := appwrite.Function{
-		Client: *conn,
-	}
+	queries := buildQueries(d.Quals, executionQueryColumns)
 
-	executionsList, err := functions.ListExecutions(function_id, "", query)
-	if err != nil {
-		plugin.Logger(ctx).Error("appwrite_execution.listExecutions", "api_error", err)
-		return nil, err
-	}
-	plugin.Logger(ctx).Trace("appwrite_execution.listExecutions", "response", executionsList)
-	executions := *executionsList
-	for _, execution := range executions.Executions {
+	path := apiPath("/functions/%s/executions", functionId)
+	err = listAll(ctx, d, conn, path, "executions", searchParams(search), queries, func(execution appwrite.ExecutionObject) {
 		row := executionsRow{
-			Execution: execution,
-			Search:    search,
-			Query:     query,
+			Execution:  execution,
+			FunctionId: functionId,
+			Search:     search,
 		}
 		d.StreamListItem(ctx, row)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_execution.listExecutions", "api_error", err)
+		return nil, err
 	}
 	return nil, nil
 }
+
+func getExecution(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	functionId := d.EqualsQuals["function_id"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_execution.getExecution", "connection_error", err)
+		return nil, err
+	}
+
+	execution, err := getOne[appwrite.ExecutionObject](ctx, conn, "/functions/%s/executions/%s", functionId, id)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_execution.getExecution", "api_error", err)
+		return nil, err
+	}
+
+	return executionsRow{Execution: execution, FunctionId: functionId}, nil
+}
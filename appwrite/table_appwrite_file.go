@@ -2,7 +2,12 @@ package appwrite
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"strings"
+	"unicode/utf8"
 
 	appwrite "github.com/mr-destructive/appwrite-go-sdk"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
@@ -15,20 +20,25 @@ func tableAppwriteFile(ctx context.Context) *plugin.Table {
 		Name:        "appwrite_file",
 		Description: "Query files meta information in a bucket for an appwrite project",
 		List: &plugin.ListConfig{
-			Hydrate: listFiles,
-			KeyColumns: []*plugin.KeyColumn{
+			ParentHydrate: listParentBuckets,
+			Hydrate:       childList(listFiles),
+			KeyColumns: append([]*plugin.KeyColumn{
 				{Name: "bucket_id", Require: plugin.Optional},
 				{Name: "search_query", Require: plugin.Optional},
 				{Name: "settings", Require: plugin.Optional},
-			},
+			}, queryKeyColumns(fileQueryColumns)...),
 		},
-		Columns: []*plugin.Column{
+		Get: &plugin.GetConfig{
+			Hydrate:    getFile,
+			KeyColumns: plugin.AllColumns([]string{"bucket_id", "id"}),
+		},
+		Columns: commonColumns([]*plugin.Column{
 			// Result columns
 			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "The unique file ID."},
 			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "The Name of the file."},
 			{Name: "title", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "The Name or ID of the file."},
-			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.FromField("CreatedAt"), Description: "The file creation date in ISO 8601 format."},
-			{Name: "updated_at", Type: proto.ColumnType_STRING, Transform: transform.FromField("UpdatedAt"), Description: "The file updation date in ISO 8601 format."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("CreatedAt").Transform(toTimestamp), Description: "The file creation date."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("UpdatedAt").Transform(toTimestamp), Description: "The file updation date."},
 			{Name: "permissions", Type: proto.ColumnType_JSON, Transform: transform.FromField("Permissions"), Description: "The permission setting(list of strings) for the file access."},
 			{Name: "signature", Type: proto.ColumnType_STRING, Transform: transform.FromField("Signature"), Description: "The MD5 signature for the file."},
 			{Name: "mime_type", Type: proto.ColumnType_STRING, Transform: transform.FromField("MimeType"), Description: "The mime type for the file."},
@@ -36,11 +46,16 @@ func tableAppwriteFile(ctx context.Context) *plugin.Table {
 			{Name: "chunks_total", Type: proto.ColumnType_INT, Transform: transform.FromField("ChunksTotal"), Description: "The total number of chunks available for the file."},
 			{Name: "chunks_uploaded", Type: proto.ColumnType_INT, Transform: transform.FromField("ChunksUploaded"), Description: "The total number of chunks of file which have been uploaded."},
 
+			// Content columns, downloaded only when selected
+			{Name: "content_base64", Type: proto.ColumnType_STRING, Hydrate: getFileContent, Transform: transform.FromField("Base64"), Description: "The base64 encoded content of the file. Null for files larger than 10 MiB."},
+			{Name: "content_text", Type: proto.ColumnType_STRING, Hydrate: getFileContent, Transform: transform.FromField("Text"), Description: "The content of text files, e.g. JSON or CSV, of up to 1 MiB. Null for other files."},
+			{Name: "sha256", Type: proto.ColumnType_STRING, Hydrate: getFileContent, Transform: transform.FromField("Sha256"), Description: "The hex encoded SHA-256 digest of the content of the file. Null for files larger than 10 MiB."},
+
 			// Input Columns
-			{Name: "bucket_id", Type: proto.ColumnType_STRING, Transform: transform.FromQual("bucket_id"), Description: "The unique ID for the bucket to list the files from."},
+			{Name: "bucket_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("BucketId"), Description: "The unique ID for the bucket to list the files from."},
 			{Name: "search_query", Type: proto.ColumnType_STRING, Transform: transform.FromField("Search"), Description: "The string of query type to filter the results from the request."},
 			{Name: "settings", Type: proto.ColumnType_JSON, Transform: transform.FromQual("settings"), Description: "Settings is a JSONB object that accepts any of the completion API request parameters."},
-		},
+		}),
 	}
 }
 
@@ -49,13 +64,25 @@ type filesRequestQual struct {
 	Search   *string
 }
 
+var fileQueryColumns = []queryColumn{
+	{Name: "id", Attribute: "$id", Operators: stringOperators},
+	{Name: "name", Attribute: "name", Operators: stringOperators},
+	{Name: "created_at", Attribute: "$createdAt", Operators: numberOperators},
+	{Name: "updated_at", Attribute: "$updatedAt", Operators: numberOperators},
+	{Name: "signature", Attribute: "signature", Operators: stringOperators},
+	{Name: "mime_type", Attribute: "mimeType", Operators: stringOperators},
+	{Name: "size_original", Attribute: "sizeOriginal", Operators: numberOperators},
+	{Name: "chunks_total", Attribute: "chunksTotal", Operators: numberOperators},
+	{Name: "chunks_uploaded", Attribute: "chunksUploaded", Operators: numberOperators},
+}
+
 type filesRow struct {
 	appwrite.File
 	BucketId string
 	Search   string
 }
 
-func listFiles(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func listFiles(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 
 	conn, err := connect(ctx, d)
 	if err != nil {
@@ -73,22 +100,101 @@ func listFiles(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 		}
 	}
 
-	storage := appwrite.Storage{
-		Client: *conn,
-	}
-	bucketId := d.EqualsQuals["bucket_id"].GetStringValue()
+	bucketId := h.Item.(appwrite.Bucket).Id
 	search := d.EqualsQuals["search_query"].GetStringValue()
 
-	filesList, err := storage.ListFiles(bucketId, search, 0, 0, "")
+	queries := buildQueries(d.Quals, fileQueryColumns)
+
+	path := apiPath("/storage/buckets/%s/files", bucketId)
+	err = listAll(ctx, d, conn, path, "files", searchParams(search), queries, func(f appwrite.File) {
+		row := filesRow{f, bucketId, search}
+		d.StreamListItem(ctx, row)
+	})
 	if err != nil {
 		plugin.Logger(ctx).Error("appwrite_file.listFiles", "api_error", err)
 		return nil, err
 	}
-	plugin.Logger(ctx).Trace("appwrite_file.listFiles", "response", filesList)
-	files := *filesList
-	for _, f := range files.Files {
-		row := filesRow{f, bucketId, search}
-		d.StreamListItem(ctx, row)
-	}
 	return nil, nil
 }
+
+func getFile(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	bucketId := d.EqualsQuals["bucket_id"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_file.getFile", "connection_error", err)
+		return nil, err
+	}
+
+	f, err := getOne[appwrite.File](ctx, conn, "/storage/buckets/%s/files/%s", bucketId, id)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_file.getFile", "api_error", err)
+		return nil, err
+	}
+
+	return filesRow{File: f, BucketId: bucketId}, nil
+}
+
+// maxFileContentSize is the size of the largest file downloaded for its
+// content columns.
+const maxFileContentSize = 10 << 20
+
+// maxFileTextSize is the size of the largest file returned as content_text.
+const maxFileTextSize = 1 << 20
+
+// fileContent is the content of a file downloaded for its content columns.
+type fileContent struct {
+	Base64 string
+	Text   *string
+	Sha256 string
+}
+
+func getFileContent(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	f := h.Item.(filesRow)
+
+	// Leave the content of large files null rather than load them into memory
+	if f.SizeOriginal > maxFileContentSize {
+		return nil, nil
+	}
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_file.getFileContent", "connection_error", err)
+		return nil, err
+	}
+
+	content, err := conn.get(ctx, apiPath("/storage/buckets/%s/files/%s/download", f.BucketId, f.Id), nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_file.getFileContent", "api_error", err)
+		return nil, err
+	}
+
+	sum := sha256.Sum256(content)
+	result := fileContent{
+		Base64: base64.StdEncoding.EncodeToString(content),
+		Sha256: hex.EncodeToString(sum[:]),
+	}
+	if isTextMimeType(f.MimeType) && len(content) <= maxFileTextSize && utf8.Valid(content) {
+		text := string(content)
+		result.Text = &text
+	}
+	return result, nil
+}
+
+// isTextMimeType reports whether files of mimeType hold text, e.g. text/csv or
+// application/json.
+func isTextMimeType(mimeType string) bool {
+	mimeType, _, _ = strings.Cut(mimeType, ";")
+	switch {
+	case strings.HasPrefix(mimeType, "text/"),
+		strings.HasSuffix(mimeType, "+json"),
+		strings.HasSuffix(mimeType, "+xml"):
+		return true
+	}
+	switch mimeType {
+	case "application/json", "application/xml", "application/csv", "application/yaml", "application/x-yaml", "application/toml", "application/javascript":
+		return true
+	}
+	return false
+}
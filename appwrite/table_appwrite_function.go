@@ -16,19 +16,22 @@ func tableAppwriteFunction(ctx context.Context) *plugin.Table {
 		Description: "Query meta information of a function for an appwrite project",
 		List: &plugin.ListConfig{
 			Hydrate: listFunctions,
-			KeyColumns: []*plugin.KeyColumn{
+			KeyColumns: append([]*plugin.KeyColumn{
 				{Name: "search_query", Require: plugin.Optional},
-				{Name: "query", Require: plugin.Optional},
 				{Name: "settings", Require: plugin.Optional},
-			},
+			}, queryKeyColumns(functionQueryColumns)...),
 		},
-		Columns: []*plugin.Column{
+		Get: &plugin.GetConfig{
+			Hydrate:    getFunction,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		Columns: commonColumns([]*plugin.Column{
 			// Result columns
 			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Function.Id"), Description: "The unique ID for the function."},
 			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Function.Name"), Description: "The Name of the function."},
 			{Name: "title", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "The Name or ID of the function."},
-			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.FromField("Function.CreatedAt"), Description: "Function creation date in ISO 8601 format."},
-			{Name: "updated_at", Type: proto.ColumnType_STRING, Transform: transform.FromField("Function.UpdatedAt"), Description: "Function updation date in ISO 8601 format."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Function.CreatedAt").Transform(toTimestamp), Description: "Function creation date."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Function.UpdatedAt").Transform(toTimestamp), Description: "Function updation date."},
 			{Name: "execute", Type: proto.ColumnType_STRING, Transform: transform.FromField("Function.Execute"), Description: "A list of string as permissions for the execution of the function."},
 			{Name: "enabled", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Function.Enabled"), Description: "A boolean flag to indicate if the function is enabled."},
 			{Name: "variable", Type: proto.ColumnType_STRING, Transform: transform.FromField("Function.Variable"), Description: "The list of variables for the function."},
@@ -36,26 +39,34 @@ func tableAppwriteFunction(ctx context.Context) *plugin.Table {
 			{Name: "deployment", Type: proto.ColumnType_STRING, Transform: transform.FromField("Function.Deployment"), Description: "Function's active deployment ID."},
 			{Name: "events", Type: proto.ColumnType_STRING, Transform: transform.FromField("Function.Events"), Description: "The list of trigger events for the function."},
 			{Name: "schedule", Type: proto.ColumnType_STRING, Transform: transform.FromField("Function.Schedule"), Description: "The schedule for the function execution in CRON format."},
-			{Name: "schedule_next", Type: proto.ColumnType_STRING, Transform: transform.FromField("Function.ScheduleNext"), Description: "The next scheduled execution time of function in ISO 8601 format."},
-			{Name: "schedule_previous", Type: proto.ColumnType_STRING, Transform: transform.FromField("Function.SchedulePrevious"), Description: "The previous scheduled execution time of function in ISO 8601 format."},
+			{Name: "schedule_next", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Function.ScheduleNext").Transform(toTimestamp), Description: "The next scheduled execution time of function."},
+			{Name: "schedule_previous", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Function.SchedulePrevious").Transform(toTimestamp), Description: "The previous scheduled execution time of function."},
 			{Name: "timeout", Type: proto.ColumnType_STRING, Transform: transform.FromField("Function.Timeout"), Description: "The execution time of the function in seconds."},
 
 			// Input Columns
 			{Name: "search_query", Type: proto.ColumnType_STRING, Transform: transform.FromField("Search"), Description: "The string as a search filter the results from the request."},
-			{Name: "query", Type: proto.ColumnType_STRING, Transform: transform.FromField("Query"), Description: "The string of query type to filter the results from the request."},
 			{Name: "settings", Type: proto.ColumnType_JSON, Transform: transform.FromQual("settings"), Description: "Settings is a JSONB object that accepts any of the completion API request parameters."},
-		},
+		}),
 	}
 }
 
 type functionssRequestQual struct {
-	Search *string   `json:"search_query"`
-	Query  *[]string `json:"query"`
+	Search *string `json:"search_query"`
+}
+
+var functionQueryColumns = []queryColumn{
+	{Name: "id", Attribute: "$id", Operators: stringOperators},
+	{Name: "name", Attribute: "name", Operators: stringOperators},
+	{Name: "created_at", Attribute: "$createdAt", Operators: numberOperators},
+	{Name: "updated_at", Attribute: "$updatedAt", Operators: numberOperators},
+	{Name: "enabled", Attribute: "enabled", Operators: boolOperators},
+	{Name: "runtime", Attribute: "runtime", Operators: stringOperators},
+	{Name: "deployment", Attribute: "deployment", Operators: stringOperators},
+	{Name: "schedule", Attribute: "schedule", Operators: stringOperators},
 }
 
 type functionsRow struct {
 	Function appwrite.FunctionObject
-	Query    []string
 	Search   string
 }
 
@@ -67,19 +78,6 @@ func listFunctions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDa
 		return nil, err
 	}
 
-	queryString := d.EqualsQuals["query"].GetStringValue()
-	var query []string
-
-	if queryString != "" {
-		err := json.Unmarshal([]byte(queryString), &query)
-		if err != nil {
-			plugin.Logger(ctx).Error("appwrite_function.listFunctions", "connection_error", err)
-			return nil, err
-		}
-	}
-	if len(query) == 0 {
-		query = []string{}
-	}
 	search := d.EqualsQuals["search_query"].GetStringValue()
 
 	settingsString := d.EqualsQuals["settings"].GetJsonbValue()
@@ -90,32 +88,79 @@ func listFunctions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDa
 			plugin.Logger(ctx).Error("appwrite_function.listFunctions", "unmarshal_error", err)
 			return nil, err
 		}
-		if crQual.Query != nil {
-			query = *crQual.Query
-		}
 		if crQual.Search != nil {
 			search = *crQual.Search
 		}
 	}
 
-	functions := appwrite.Function{
-		Client: *conn,
-	}
+	queries := buildQueries(d.Quals, functionQueryColumns)
 
-	functionsList, err := functions.ListFunctions(search, query)
-	if err != nil {
-		plugin.Logger(ctx).Error("appwrite_function.listFunctions", "api_error", err)
-		return nil, err
-	}
-	plugin.Logger(ctx).Trace("appwrite_function.listFunctions", "response", functionsList)
-	funcs := *functionsList
-	for _, f := range funcs.Functions {
+	err = listAll(ctx, d, conn, "/functions", "functions", searchParams(search), queries, func(f appwrite.FunctionObject) {
 		row := functionsRow{
-			Function: f,
-			Query:    query,
+			Function: maskFunctionVariables(d, f),
 			Search:   search,
 		}
 		d.StreamListItem(ctx, row)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_function.listFunctions", "api_error", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getFunction(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQuals["id"].GetStringValue()
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_function.getFunction", "connection_error", err)
+		return nil, err
+	}
+
+	f, err := getOne[appwrite.FunctionObject](ctx, conn, "/functions/%s", id)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_function.getFunction", "api_error", err)
+		return nil, err
+	}
+
+	return functionsRow{Function: maskFunctionVariables(d, f)}, nil
+}
+
+// maskFunctionVariables hides the values of the function's variables unless
+// the connection reveals secrets.
+func maskFunctionVariables(d *plugin.QueryData, f appwrite.FunctionObject) appwrite.FunctionObject {
+	if len(f.Variable) == 0 {
+		return f
+	}
+	variables := make([]appwrite.Variable, len(f.Variable))
+	for i, variable := range f.Variable {
+		variables[i] = maskVariable(d, variable)
+	}
+	f.Variable = variables
+	return f
+}
+
+// listParentFunctions is the parent list of tables nested under functions.
+// It streams the function given by function_id, or every function otherwise.
+func listParentFunctions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	if functionId := d.EqualsQuals["function_id"].GetStringValue(); functionId != "" {
+		d.StreamListItem(ctx, appwrite.FunctionObject{Id: functionId})
+		return nil, nil
+	}
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_function.listParentFunctions", "connection_error", err)
+		return nil, err
+	}
+
+	err = listAll(ctx, d, conn, "/functions", "functions", nil, nil, func(f appwrite.FunctionObject) {
+		d.StreamListItem(ctx, f)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_function.listParentFunctions", "api_error", err)
+		return nil, err
 	}
 	return nil, nil
 }
@@ -0,0 +1,96 @@
+package appwrite
+
+import (
+	"context"
+
+	appwrite "github.com/mr-destructive/appwrite-go-sdk"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAppwriteFunctionVariable(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "appwrite_function_variable",
+		Description: "Query environment variables of functions in an appwrite project",
+		List: &plugin.ListConfig{
+			ParentHydrate: listParentFunctions,
+			Hydrate:       childList(listFunctionVariables),
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "function_id", Require: plugin.Optional},
+			},
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getFunctionVariable,
+			KeyColumns: plugin.AllColumns([]string{"function_id", "id"}),
+		},
+		Columns: commonColumns(variableColumns([]*plugin.Column{
+			{Name: "function_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FunctionId"), Description: "The ID of the function the variable belongs to."},
+		})),
+	}
+}
+
+// variableColumns returns the columns of a variable table, followed by the
+// table's own columns.
+func variableColumns(columns []*plugin.Column) []*plugin.Column {
+	return append([]*plugin.Column{
+		{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "The unique ID of the variable."},
+		{Name: "title", Type: proto.ColumnType_STRING, Transform: transform.FromField("Key"), Description: "The key of the variable."},
+		{Name: "key", Type: proto.ColumnType_STRING, Transform: transform.FromField("Key"), Description: "The key of the variable."},
+		{Name: "value", Type: proto.ColumnType_STRING, Transform: transform.FromField("Value"), Description: "The value of the variable. Null unless reveal_secrets is set in the connection config."},
+		{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("CreatedAt").Transform(toTimestamp), Description: "Variable creation date."},
+		{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("UpdatedAt").Transform(toTimestamp), Description: "Variable update date."},
+	}, columns...)
+}
+
+// maskVariable hides the value of variable unless the connection reveals
+// secrets.
+func maskVariable(d *plugin.QueryData, variable appwrite.Variable) appwrite.Variable {
+	if revealSecrets := GetConfig(d.Connection).RevealSecrets; revealSecrets == nil || !*revealSecrets {
+		variable.Value = ""
+	}
+	return variable
+}
+
+func listFunctionVariables(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_function_variable.listFunctionVariables", "connection_error", err)
+		return nil, err
+	}
+
+	functionId := h.Item.(appwrite.FunctionObject).Id
+
+	// Variables are returned in a single response, without pagination
+	path := apiPath("/functions/%s/variables", functionId)
+	err = listUnpaged(ctx, d, conn, path, "variables", func(variable appwrite.Variable) {
+		variable.FunctionId = functionId
+		d.StreamListItem(ctx, maskVariable(d, variable))
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_function_variable.listFunctionVariables", "api_error", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getFunctionVariable(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	functionId := d.EqualsQuals["function_id"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_function_variable.getFunctionVariable", "connection_error", err)
+		return nil, err
+	}
+
+	variable, err := getOne[appwrite.Variable](ctx, conn, "/functions/%s/variables/%s", functionId, id)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_function_variable.getFunctionVariable", "api_error", err)
+		return nil, err
+	}
+	variable.FunctionId = functionId
+
+	return maskVariable(d, variable), nil
+}
@@ -3,6 +3,11 @@ package appwrite
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
 
 	appwrite "github.com/mr-destructive/appwrite-go-sdk"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
@@ -18,22 +23,32 @@ func tableAppwriteHealth(ctx context.Context) *plugin.Table {
 			Hydrate: health,
 			KeyColumns: []*plugin.KeyColumn{
 				{Name: "service", Require: plugin.Optional},
+				{Name: "domain", Require: plugin.Optional},
 				{Name: "settings", Require: plugin.Optional},
 			},
 		},
-		Columns: []*plugin.Column{
+		Columns: commonColumns([]*plugin.Column{
 			// Result columns
-			{Name: "ping", Type: proto.ColumnType_INT, Transform: transform.FromField("Status.Ping"), Description: "Duration in milliseconds how long the health check took."},
-			{Name: "status", Type: proto.ColumnType_STRING, Transform: transform.FromField("Status.Status"), Description: "Service status. Possible values can are: pass, fail"},
-			{Name: "real_time", Type: proto.ColumnType_INT, Transform: transform.FromField("Time.RealTime"), Description: "Current unix timestamp on trustful remote server."},
-			{Name: "local_time", Type: proto.ColumnType_INT, Transform: transform.FromField("Time.LocalTime"), Description: "Current unix timestamp of local server where Appwrite runs."},
-			{Name: "diff", Type: proto.ColumnType_INT, Transform: transform.FromField("Time.Diff"), Description: "Difference of unix remote and local timestamps in milliseconds."},
-			{Name: "size", Type: proto.ColumnType_INT, Transform: transform.FromField("Queue.Size"), Description: "Amount of actions in the queue."},
+			{Name: "ping", Type: proto.ColumnType_INT, Transform: transform.FromField("Result.Ping"), Description: "Duration in milliseconds how long the health check took."},
+			{Name: "status", Type: proto.ColumnType_STRING, Transform: transform.FromField("Result.Status"), Description: "Service status. Possible values can are: pass, fail. The antivirus reports online, offline or disabled."},
+			{Name: "error", Type: proto.ColumnType_STRING, Transform: transform.FromField("Error"), Description: "The error of the health check if it failed."},
+			{Name: "checked_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("CheckedAt"), Description: "The time the health check completed."},
+			{Name: "real_time", Type: proto.ColumnType_INT, Transform: transform.FromField("Result.RealTime"), Description: "Current unix timestamp on trustful remote server."},
+			{Name: "local_time", Type: proto.ColumnType_INT, Transform: transform.FromField("Result.LocalTime"), Description: "Current unix timestamp of local server where Appwrite runs."},
+			{Name: "diff", Type: proto.ColumnType_INT, Transform: transform.FromField("Result.Diff"), Description: "Difference of unix remote and local timestamps in milliseconds."},
+			{Name: "size", Type: proto.ColumnType_INT, Transform: transform.FromField("Result.Size"), Description: "Amount of actions in the queue."},
+			{Name: "version", Type: proto.ColumnType_STRING, Transform: transform.FromField("Result.Version"), Description: "The antivirus version."},
+			{Name: "certificate_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Result.Name"), Description: "The name of the domain's certificate."},
+			{Name: "certificate_subject", Type: proto.ColumnType_STRING, Transform: transform.FromField("Result.SubjectSN"), Description: "The subject of the domain's certificate."},
+			{Name: "certificate_issuer", Type: proto.ColumnType_STRING, Transform: transform.FromField("Result.IssuerOrganisation"), Description: "The organisation which issued the domain's certificate."},
+			{Name: "certificate_valid_from", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Result.ValidFrom").Transform(transform.NullIfZeroValue).Transform(transform.UnixToTimestamp), Description: "The start of the domain's certificate validity."},
+			{Name: "certificate_valid_to", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Result.ValidTo").Transform(transform.NullIfZeroValue).Transform(transform.UnixToTimestamp), Description: "The end of the domain's certificate validity."},
 
 			// Input Columns
-			{Name: "service", Type: proto.ColumnType_STRING, Transform: transform.FromField("Service"), Description: "The string value as either http, http,db,cache, local-storage, function-queue, logs-queue, webhooks-queue, or time"},
+			{Name: "service", Type: proto.ColumnType_STRING, Transform: transform.FromField("Service"), Description: "The service checked, as one of http, db, cache, pubsub, queue, local-storage, storage, antivirus, time, certificate, or a queue as builds-queue, certificates-queue, databases-queue, deletes-queue, function-queue, logs-queue, mails-queue, messaging-queue, migrations-queue, usage-queue or webhooks-queue."},
+			{Name: "domain", Type: proto.ColumnType_STRING, Transform: transform.FromField("Domain"), Description: "The domain whose certificate validity to check. The certificate service is only checked if given."},
 			{Name: "settings", Type: proto.ColumnType_JSON, Transform: transform.FromQual("settings"), Description: "Settings is a JSONB object that accepts any of the completion API request parameters."},
-		},
+		}),
 	}
 }
 
@@ -41,10 +56,71 @@ type healthsRequestQual struct {
 	Service string `json:"service"`
 }
 
+// healthService is a service checked by the health API.
+type healthService struct {
+	Name string
+	Path string
+}
+
+// healthServices are the services checked, in the order they're listed.
+var healthServices = []healthService{
+	{Name: "http", Path: "/health"},
+	{Name: "db", Path: "/health/db"},
+	{Name: "cache", Path: "/health/cache"},
+	{Name: "pubsub", Path: "/health/pubsub"},
+	{Name: "queue", Path: "/health/queue"},
+	{Name: "local-storage", Path: "/health/storage/local"},
+	{Name: "storage", Path: "/health/storage"},
+	{Name: "antivirus", Path: "/health/anti-virus"},
+	{Name: "time", Path: "/health/time"},
+	{Name: "certificate", Path: "/health/certificate"},
+	{Name: "builds-queue", Path: "/health/queue/builds"},
+	{Name: "certificates-queue", Path: "/health/queue/certificates"},
+	{Name: "databases-queue", Path: "/health/queue/databases"},
+	{Name: "deletes-queue", Path: "/health/queue/deletes"},
+	{Name: "function-queue", Path: "/health/queue/functions"},
+	{Name: "logs-queue", Path: "/health/queue/logs"},
+	{Name: "mails-queue", Path: "/health/queue/mails"},
+	{Name: "messaging-queue", Path: "/health/queue/messaging"},
+	{Name: "migrations-queue", Path: "/health/queue/migrations"},
+	{Name: "usage-queue", Path: "/health/queue/usage"},
+	{Name: "webhooks-queue", Path: "/health/queue/webhooks"},
+}
+
+// healthResult holds the response of any health check, each of which only
+// sets its own fields.
+type healthResult struct {
+	Ping   int    `json:"ping"`
+	Status string `json:"status"`
+
+	// Queue
+	Size int `json:"size"`
+
+	// Time
+	RealTime  int `json:"realTime"`
+	LocalTime int `json:"localTime"`
+	Diff      int `json:"diff"`
+
+	// Antivirus
+	Version string `json:"version"`
+
+	// Certificate
+	Name               string `json:"name"`
+	SubjectSN          string `json:"subjectSN"`
+	IssuerOrganisation string `json:"issuerOrganisation"`
+	ValidFrom          string `json:"validFrom"`
+	ValidTo            string `json:"validTo"`
+
+	// Services with several instances, e.g. db and cache, list a status each
+	Statuses []appwrite.HealthStatus `json:"statuses"`
+}
+
 type healthRow struct {
-	Status appwrite.HealthStatus
-	Queue  appwrite.HealthQueue
-	Time   appwrite.HealthTime
+	Service   string
+	Domain    string
+	Result    healthResult
+	Error     string
+	CheckedAt time.Time
 }
 
 func health(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
@@ -54,7 +130,11 @@ func health(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (in
 		plugin.Logger(ctx).Error("appwrite_health.health", "connection_error", err)
 		return nil, err
 	}
-	service := d.EqualsQuals["service"].GetStringValue()
+	var names []string
+	if service := d.EqualsQuals["service"].GetStringValue(); service != "" {
+		names = []string{service}
+	}
+	domain := d.EqualsQuals["domain"].GetStringValue()
 
 	settingsString := d.EqualsQuals["settings"].GetJsonbValue()
 	if settingsString != "" {
@@ -64,43 +144,123 @@ func health(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (in
 			plugin.Logger(ctx).Error("appwrite_health.health", "unmarshal_error", err)
 			return nil, err
 		}
+		if crQual.Service != "" {
+			names = []string{crQual.Service}
+		}
 	}
-	client := *conn
-	var row interface{}
-
-	switch service {
-	case "http":
-		response, _ := client.Health()
-		row = healthRow{Status: *response}
-	case "db":
-		response, _ := client.DBHealth()
-		row = healthRow{Status: *response}
-	case "cache":
-		response, _ := client.CacheHealth()
-		row = healthRow{Status: *response}
-	case "local-storage":
-		response, _ := client.LocalStorageHealth()
-		row = healthRow{Status: *response}
-	case "function-queue":
-		response, _ := client.FunctionsQueue()
-		row = healthRow{Queue: *response}
-	case "logs-queue":
-		response, _ := client.LogsQueue()
-		row = healthRow{Queue: *response}
-	case "webhooks-queue":
-		response, _ := client.WebHooksQueue()
-		row = healthRow{Queue: *response}
-	case "time":
-		response, _ := client.TimeHealth()
-		row = healthRow{Time: *response}
-	default:
-		response, _ := client.TimeHealth()
-		row = healthRow{Time: *response}
-	}
+
+	services, err := selectHealthServices(names, domain)
 	if err != nil {
-		plugin.Logger(ctx).Error("appwrite_health.health", "api_error", err)
+		plugin.Logger(ctx).Error("appwrite_health.health", "invalid_service", err)
 		return nil, err
 	}
-	d.StreamListItem(ctx, row)
+
+	// Check every service at once, but keep the listed order
+	rows := make([]healthRow, len(services))
+	var wg sync.WaitGroup
+	for i, service := range services {
+		wg.Add(1)
+		go func(i int, service healthService) {
+			defer wg.Done()
+			rows[i] = checkHealth(ctx, d, conn, service, domain)
+		}(i, service)
+	}
+	wg.Wait()
+
+	for _, row := range rows {
+		if row.Error != "" {
+			plugin.Logger(ctx).Warn("appwrite_health.health", "service", row.Service, "api_error", row.Error)
+		}
+		d.StreamListItem(ctx, row)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
 	return nil, nil
 }
+
+// selectHealthServices returns the services named, or every service if none
+// are. The certificate is only checked for a domain.
+func selectHealthServices(names []string, domain string) ([]healthService, error) {
+	if len(names) == 0 {
+		var services []healthService
+		for _, service := range healthServices {
+			if service.Name != "certificate" || domain != "" {
+				services = append(services, service)
+			}
+		}
+		return services, nil
+	}
+
+	var services []healthService
+	for _, name := range names {
+		found := false
+		for _, service := range healthServices {
+			if service.Name == name {
+				services = append(services, service)
+				found = true
+				break
+			}
+		}
+		if !found {
+			valid := make([]string, len(healthServices))
+			for i, service := range healthServices {
+				valid[i] = service.Name
+			}
+			return nil, fmt.Errorf("unknown health service %q, expected one of %s", name, strings.Join(valid, ", "))
+		}
+	}
+	return services, nil
+}
+
+// checkHealth checks a single service. Failed checks are returned as a row
+// with status fail rather than an error, so one broken service doesn't hide
+// the health of the others.
+func checkHealth(ctx context.Context, d *plugin.QueryData, conn *appwriteClient, service healthService, domain string) healthRow {
+	row := healthRow{Service: service.Name, Domain: domain}
+
+	var params url.Values
+	if service.Name == "certificate" {
+		if domain == "" {
+			row.Result.Status = "fail"
+			row.Error = "domain is required to check the certificate"
+			row.CheckedAt = time.Now()
+			return row
+		}
+		params = url.Values{"domain": {domain}}
+	}
+
+	check := func(ctx context.Context, _ *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+		return conn.get(ctx, service.Path, params)
+	}
+	resp, err := plugin.RetryHydrate(ctx, d, nil, check, d.Table.List.RetryConfig)
+	row.CheckedAt = time.Now()
+	if err == nil {
+		err = json.Unmarshal(resp.([]byte), &row.Result)
+	}
+	if err != nil {
+		row.Result = healthResult{Status: "fail"}
+		row.Error = err.Error()
+		return row
+	}
+
+	if len(row.Result.Statuses) > 0 {
+		// Report the slowest instance, and fail if any instance fails
+		row.Result.Status = "pass"
+		for _, status := range row.Result.Statuses {
+			if status.Ping > row.Result.Ping {
+				row.Result.Ping = status.Ping
+			}
+			if status.Status != "pass" {
+				row.Result.Status = status.Status
+			}
+		}
+	}
+	// Queues, time and certificates answer without a status when healthy
+	if row.Result.Status == "" {
+		row.Result.Status = "pass"
+	}
+	return row
+}
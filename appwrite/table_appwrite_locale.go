@@ -0,0 +1,104 @@
+package appwrite
+
+import (
+	"context"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAppwriteLocale(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "appwrite_locale",
+		Description: "Query the locale appwrite detects for the plugin's IP address",
+		List: &plugin.ListConfig{
+			Hydrate: getLocale,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			// Result columns
+			{Name: "ip", Type: proto.ColumnType_IPADDR, Transform: transform.FromField("Ip"), Description: "The IP address the locale was detected from."},
+			{Name: "country_code", Type: proto.ColumnType_STRING, Transform: transform.FromField("CountryCode"), Description: "The country code in ISO 3166-1 two-character format."},
+			{Name: "country", Type: proto.ColumnType_STRING, Transform: transform.FromField("Country"), Description: "The country name."},
+			{Name: "continent_code", Type: proto.ColumnType_STRING, Transform: transform.FromField("ContinentCode"), Description: "The continent two letter code."},
+			{Name: "continent", Type: proto.ColumnType_STRING, Transform: transform.FromField("Continent"), Description: "The continent name."},
+			{Name: "eu", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Eu"), Description: "A boolean value for checking if the country is part of the European Union."},
+			{Name: "currency", Type: proto.ColumnType_STRING, Transform: transform.FromField("Currency"), Description: "The currency code in ISO 4217 three-character format."},
+		}),
+	}
+}
+
+// locale is the locale Appwrite detects for the caller's IP address.
+type locale struct {
+	Ip            string `json:"ip"`
+	CountryCode   string `json:"countryCode"`
+	Country       string `json:"country"`
+	ContinentCode string `json:"continentCode"`
+	Continent     string `json:"continent"`
+	Eu            bool   `json:"eu"`
+	Currency      string `json:"currency"`
+}
+
+func getLocale(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_locale.getLocale", "connection_error", err)
+		return nil, err
+	}
+
+	l, err := getOne[locale](ctx, conn, "/locale")
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_locale.getLocale", "api_error", err)
+		return nil, err
+	}
+	d.StreamListItem(ctx, l)
+
+	return nil, nil
+}
+
+// localeTTL is how long locale reference data, which only changes with
+// Appwrite releases, is cached.
+const localeTTL = 24 * time.Hour
+
+// localeList returns a hydrate function fetching the items found under key of
+// the locale list endpoint at path, memoized for localeTTL.
+func localeList[T any](path string, key string) plugin.HydrateFunc {
+	fetch := func(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+		conn, err := connect(ctx, d)
+		if err != nil {
+			return nil, err
+		}
+		var items []T
+		err = listUnpaged(ctx, nil, conn, path, key, func(item T) {
+			items = append(items, item)
+		})
+		return items, err
+	}
+
+	return plugin.HydrateFunc(fetch).Memoize(func(config *plugin.MemoizeConfiguration) {
+		config.Ttl = localeTTL
+		// Every list shares the fetch function, so key the cache on the path
+		config.GetCacheKeyFunc = func(context.Context, *plugin.QueryData, *plugin.HydrateData) (interface{}, error) {
+			return "appwrite-locale-" + path, nil
+		}
+	})
+}
+
+// streamLocaleList streams every item returned by the locale list hydrate.
+func streamLocaleList[T any](ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, list plugin.HydrateFunc) error {
+	items, err := list(ctx, d, h)
+	if err != nil {
+		return err
+	}
+	for _, item := range items.([]T) {
+		d.StreamListItem(ctx, item)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package appwrite
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAppwriteLocaleContinent(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "appwrite_locale_continent",
+		Description: "Query the continents known to appwrite",
+		List: &plugin.ListConfig{
+			Hydrate: listLocaleContinents,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			// Result columns
+			{Name: "code", Type: proto.ColumnType_STRING, Transform: transform.FromField("Code"), Description: "The continent two letter code."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "The continent name."},
+		}),
+	}
+}
+
+// localeContinent is a continent of the Appwrite locale service.
+type localeContinent struct {
+	Name string `json:"name"`
+	Code string `json:"code"`
+}
+
+var listLocaleContinentsCached = localeList[localeContinent]("/locale/continents", "continents")
+
+func listLocaleContinents(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	err := streamLocaleList[localeContinent](ctx, d, h, listLocaleContinentsCached)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_locale_continent.listLocaleContinents", "api_error", err)
+		return nil, err
+	}
+	return nil, nil
+}
@@ -0,0 +1,41 @@
+package appwrite
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAppwriteLocaleCountry(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "appwrite_locale_country",
+		Description: "Query the countries known to appwrite",
+		List: &plugin.ListConfig{
+			Hydrate: listLocaleCountries,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			// Result columns
+			{Name: "code", Type: proto.ColumnType_STRING, Transform: transform.FromField("Code"), Description: "The country code in ISO 3166-1 two-character format."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "The country name."},
+		}),
+	}
+}
+
+// localeCountry is a country of the Appwrite locale service.
+type localeCountry struct {
+	Name string `json:"name"`
+	Code string `json:"code"`
+}
+
+var listLocaleCountriesCached = localeList[localeCountry]("/locale/countries", "countries")
+
+func listLocaleCountries(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	err := streamLocaleList[localeCountry](ctx, d, h, listLocaleCountriesCached)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_locale_country.listLocaleCountries", "api_error", err)
+		return nil, err
+	}
+	return nil, nil
+}
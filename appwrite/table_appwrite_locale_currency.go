@@ -0,0 +1,51 @@
+package appwrite
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAppwriteLocaleCurrency(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "appwrite_locale_currency",
+		Description: "Query the currencies known to appwrite",
+		List: &plugin.ListConfig{
+			Hydrate: listLocaleCurrencies,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			// Result columns
+			{Name: "code", Type: proto.ColumnType_STRING, Transform: transform.FromField("Code"), Description: "The currency code in ISO 4217 three-character format."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "The currency name."},
+			{Name: "name_plural", Type: proto.ColumnType_STRING, Transform: transform.FromField("NamePlural"), Description: "The currency plural name."},
+			{Name: "symbol", Type: proto.ColumnType_STRING, Transform: transform.FromField("Symbol"), Description: "The currency symbol."},
+			{Name: "symbol_native", Type: proto.ColumnType_STRING, Transform: transform.FromField("SymbolNative"), Description: "The currency native symbol."},
+			{Name: "decimal_digits", Type: proto.ColumnType_INT, Transform: transform.FromField("DecimalDigits"), Description: "The number of decimal digits."},
+			{Name: "rounding", Type: proto.ColumnType_DOUBLE, Transform: transform.FromField("Rounding"), Description: "The currency rounding increment."},
+		}),
+	}
+}
+
+// localeCurrency is a currency of the Appwrite locale service.
+type localeCurrency struct {
+	Code          string  `json:"code"`
+	Name          string  `json:"name"`
+	NamePlural    string  `json:"namePlural"`
+	Symbol        string  `json:"symbol"`
+	SymbolNative  string  `json:"symbolNative"`
+	DecimalDigits int64   `json:"decimalDigits"`
+	Rounding      float64 `json:"rounding"`
+}
+
+var listLocaleCurrenciesCached = localeList[localeCurrency]("/locale/currencies", "currencies")
+
+func listLocaleCurrencies(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	err := streamLocaleList[localeCurrency](ctx, d, h, listLocaleCurrenciesCached)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_locale_currency.listLocaleCurrencies", "api_error", err)
+		return nil, err
+	}
+	return nil, nil
+}
@@ -0,0 +1,43 @@
+package appwrite
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAppwriteLocaleLanguage(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "appwrite_locale_language",
+		Description: "Query the languages known to appwrite",
+		List: &plugin.ListConfig{
+			Hydrate: listLocaleLanguages,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			// Result columns
+			{Name: "code", Type: proto.ColumnType_STRING, Transform: transform.FromField("Code"), Description: "The language code in ISO 639-1 two-character format."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "The language name."},
+			{Name: "native_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("NativeName"), Description: "The language native name."},
+		}),
+	}
+}
+
+// localeLanguage is a language of the Appwrite locale service.
+type localeLanguage struct {
+	Code       string `json:"code"`
+	Name       string `json:"name"`
+	NativeName string `json:"nativeName"`
+}
+
+var listLocaleLanguagesCached = localeList[localeLanguage]("/locale/languages", "languages")
+
+func listLocaleLanguages(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	err := streamLocaleList[localeLanguage](ctx, d, h, listLocaleLanguagesCached)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_locale_language.listLocaleLanguages", "api_error", err)
+		return nil, err
+	}
+	return nil, nil
+}
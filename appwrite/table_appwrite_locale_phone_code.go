@@ -0,0 +1,44 @@
+package appwrite
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAppwriteLocalePhoneCode(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "appwrite_locale_phone_code",
+		Description: "Query the phone codes of the countries known to appwrite",
+		List: &plugin.ListConfig{
+			Hydrate: listLocalePhoneCodes,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			// Result columns
+			{Name: "code", Type: proto.ColumnType_STRING, Transform: transform.FromField("Code"), Description: "The phone code, e.g. +1."},
+			{Name: "country_code", Type: proto.ColumnType_STRING, Transform: transform.FromField("CountryCode"), Description: "The country code in ISO 3166-1 two-character format."},
+			{Name: "country_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("CountryName"), Description: "The country name."},
+		}),
+	}
+}
+
+// localePhoneCode is the phone code of a country of the Appwrite locale
+// service.
+type localePhoneCode struct {
+	Code        string `json:"code"`
+	CountryCode string `json:"countryCode"`
+	CountryName string `json:"countryName"`
+}
+
+var listLocalePhoneCodesCached = localeList[localePhoneCode]("/locale/countries/phones", "phones")
+
+func listLocalePhoneCodes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	err := streamLocaleList[localePhoneCode](ctx, d, h, listLocalePhoneCodesCached)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_locale_phone_code.listLocalePhoneCodes", "api_error", err)
+		return nil, err
+	}
+	return nil, nil
+}
@@ -0,0 +1,128 @@
+package appwrite
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAppwriteMessagingMessage(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "appwrite_messaging_message",
+		Description: "Query messages sent with appwrite messaging",
+		List: &plugin.ListConfig{
+			Hydrate: listMessagingMessages,
+			KeyColumns: append([]*plugin.KeyColumn{
+				{Name: "search_query", Require: plugin.Optional},
+			}, queryKeyColumns(messagingMessageQueryColumns)...),
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getMessagingMessage,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			// Result columns
+			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Message.Id"), Description: "The unique ID of the message."},
+			{Name: "title", Type: proto.ColumnType_STRING, Transform: transform.FromField("Message.Id"), Description: "The ID of the message."},
+			{Name: "provider_type", Type: proto.ColumnType_STRING, Transform: transform.FromField("Message.ProviderType"), Description: "The type of the message. Possible values are email, sms, or push."},
+			{Name: "status", Type: proto.ColumnType_STRING, Transform: transform.FromField("Message.Status"), Description: "The status of the message. Possible values are draft, processing, scheduled, sent, or failed."},
+			{Name: "topics", Type: proto.ColumnType_JSON, Transform: transform.FromField("Message.Topics"), Description: "The IDs(list of strings) of the topics the message was sent to."},
+			{Name: "users", Type: proto.ColumnType_JSON, Transform: transform.FromField("Message.Users"), Description: "The IDs(list of strings) of the users the message was sent to."},
+			{Name: "targets", Type: proto.ColumnType_JSON, Transform: transform.FromField("Message.Targets"), Description: "The IDs(list of strings) of the targets the message was sent to."},
+			{Name: "target_count", Type: proto.ColumnType_INT, Transform: transform.FromMethod("TargetCount"), Description: "The number of targets the message was sent to directly, not counting topics and users."},
+			{Name: "scheduled_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Message.ScheduledAt").Transform(toTimestamp), Description: "The date the message is scheduled to be sent."},
+			{Name: "delivered_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Message.DeliveredAt").Transform(toTimestamp), Description: "The date the message was delivered."},
+			{Name: "delivered_total", Type: proto.ColumnType_INT, Transform: transform.FromField("Message.DeliveredTotal"), Description: "The number of recipients the message was delivered to."},
+			{Name: "delivery_errors", Type: proto.ColumnType_JSON, Transform: transform.FromField("Message.DeliveryErrors"), Description: "The errors(list of strings) raised while delivering the message."},
+			{Name: "data", Type: proto.ColumnType_JSON, Transform: transform.FromField("Message.Data"), Description: "The content of the message, which depends on its type."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Message.CreatedAt").Transform(toTimestamp), Description: "Message creation date."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Message.UpdatedAt").Transform(toTimestamp), Description: "Message update date."},
+
+			// Input Columns
+			{Name: "search_query", Type: proto.ColumnType_STRING, Transform: transform.FromField("Search"), Description: "The string to filter the results from the request."},
+		}),
+	}
+}
+
+var messagingMessageQueryColumns = []queryColumn{
+	{Name: "id", Attribute: "$id", Operators: stringOperators},
+	{Name: "provider_type", Attribute: "providerType", Operators: stringOperators},
+	{Name: "status", Attribute: "status", Operators: stringOperators},
+	{Name: "scheduled_at", Attribute: "scheduledAt", Operators: numberOperators},
+	{Name: "delivered_at", Attribute: "deliveredAt", Operators: numberOperators},
+	{Name: "delivered_total", Attribute: "deliveredTotal", Operators: numberOperators},
+	{Name: "created_at", Attribute: "$createdAt", Operators: numberOperators},
+	{Name: "updated_at", Attribute: "$updatedAt", Operators: numberOperators},
+}
+
+// messagingMessage is a message sent with Appwrite messaging.
+type messagingMessage struct {
+	Id             string                 `json:"$id"`
+	CreatedAt      string                 `json:"$createdAt"`
+	UpdatedAt      string                 `json:"$updatedAt"`
+	ProviderType   string                 `json:"providerType"`
+	Status         string                 `json:"status"`
+	Topics         []string               `json:"topics"`
+	Users          []string               `json:"users"`
+	Targets        []string               `json:"targets"`
+	ScheduledAt    string                 `json:"scheduledAt"`
+	DeliveredAt    string                 `json:"deliveredAt"`
+	DeliveredTotal int64                  `json:"deliveredTotal"`
+	DeliveryErrors []string               `json:"deliveryErrors"`
+	Data           map[string]interface{} `json:"data"`
+}
+
+type messagingMessagesRow struct {
+	Message messagingMessage
+	Search  string
+}
+
+func (r messagingMessagesRow) TargetCount() int {
+	return len(r.Message.Targets)
+}
+
+func listMessagingMessages(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_messaging_message.listMessagingMessages", "connection_error", err)
+		return nil, err
+	}
+
+	search := d.EqualsQuals["search_query"].GetStringValue()
+
+	queries := buildQueries(d.Quals, messagingMessageQueryColumns)
+
+	err = listAll(ctx, d, conn, "/messaging/messages", "messages", searchParams(search), queries, func(message messagingMessage) {
+		row := messagingMessagesRow{
+			Message: message,
+			Search:  search,
+		}
+		d.StreamListItem(ctx, row)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_messaging_message.listMessagingMessages", "api_error", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getMessagingMessage(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQuals["id"].GetStringValue()
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_messaging_message.getMessagingMessage", "connection_error", err)
+		return nil, err
+	}
+
+	message, err := getOne[messagingMessage](ctx, conn, "/messaging/messages/%s", id)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_messaging_message.getMessagingMessage", "api_error", err)
+		return nil, err
+	}
+
+	return messagingMessagesRow{Message: message}, nil
+}
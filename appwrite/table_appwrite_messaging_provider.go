@@ -0,0 +1,113 @@
+package appwrite
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAppwriteMessagingProvider(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "appwrite_messaging_provider",
+		Description: "Query messaging providers in an appwrite project",
+		List: &plugin.ListConfig{
+			Hydrate: listMessagingProviders,
+			KeyColumns: append([]*plugin.KeyColumn{
+				{Name: "search_query", Require: plugin.Optional},
+			}, queryKeyColumns(messagingProviderQueryColumns)...),
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getMessagingProvider,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			// Result columns
+			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Provider.Id"), Description: "The unique ID of the provider."},
+			{Name: "title", Type: proto.ColumnType_STRING, Transform: transform.FromField("Provider.Id"), Description: "The Name or ID of the provider."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Provider.Name"), Description: "The name of the provider."},
+			{Name: "provider", Type: proto.ColumnType_STRING, Transform: transform.FromField("Provider.Provider"), Description: "The service behind the provider, e.g. sendgrid or twilio."},
+			{Name: "type", Type: proto.ColumnType_STRING, Transform: transform.FromField("Provider.Type"), Description: "The type of messages the provider sends. Possible values are email, sms, or push."},
+			{Name: "enabled", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Provider.Enabled"), Description: "A boolean value for checking if the provider is enabled."},
+			{Name: "options", Type: proto.ColumnType_JSON, Transform: transform.FromField("Provider.Options"), Description: "The options of the provider, e.g. the sender address."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Provider.CreatedAt").Transform(toTimestamp), Description: "Provider creation date."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Provider.UpdatedAt").Transform(toTimestamp), Description: "Provider update date."},
+
+			// Input Columns
+			{Name: "search_query", Type: proto.ColumnType_STRING, Transform: transform.FromField("Search"), Description: "The string to filter the results from the request."},
+		}),
+	}
+}
+
+var messagingProviderQueryColumns = []queryColumn{
+	{Name: "id", Attribute: "$id", Operators: stringOperators},
+	{Name: "name", Attribute: "name", Operators: stringOperators},
+	{Name: "provider", Attribute: "provider", Operators: stringOperators},
+	{Name: "type", Attribute: "type", Operators: stringOperators},
+	{Name: "enabled", Attribute: "enabled", Operators: boolOperators},
+	{Name: "created_at", Attribute: "$createdAt", Operators: numberOperators},
+	{Name: "updated_at", Attribute: "$updatedAt", Operators: numberOperators},
+}
+
+// messagingProvider is an Appwrite messaging provider. The credentials
+// Appwrite returns with a provider are deliberately left out.
+type messagingProvider struct {
+	Id        string                 `json:"$id"`
+	CreatedAt string                 `json:"$createdAt"`
+	UpdatedAt string                 `json:"$updatedAt"`
+	Name      string                 `json:"name"`
+	Provider  string                 `json:"provider"`
+	Type      string                 `json:"type"`
+	Enabled   bool                   `json:"enabled"`
+	Options   map[string]interface{} `json:"options"`
+}
+
+type messagingProvidersRow struct {
+	Provider messagingProvider
+	Search   string
+}
+
+func listMessagingProviders(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_messaging_provider.listMessagingProviders", "connection_error", err)
+		return nil, err
+	}
+
+	search := d.EqualsQuals["search_query"].GetStringValue()
+
+	queries := buildQueries(d.Quals, messagingProviderQueryColumns)
+
+	err = listAll(ctx, d, conn, "/messaging/providers", "providers", searchParams(search), queries, func(provider messagingProvider) {
+		row := messagingProvidersRow{
+			Provider: provider,
+			Search:   search,
+		}
+		d.StreamListItem(ctx, row)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_messaging_provider.listMessagingProviders", "api_error", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getMessagingProvider(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQuals["id"].GetStringValue()
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_messaging_provider.getMessagingProvider", "connection_error", err)
+		return nil, err
+	}
+
+	provider, err := getOne[messagingProvider](ctx, conn, "/messaging/providers/%s", id)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_messaging_provider.getMessagingProvider", "api_error", err)
+		return nil, err
+	}
+
+	return messagingProvidersRow{Provider: provider}, nil
+}
@@ -0,0 +1,118 @@
+package appwrite
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAppwriteMessagingSubscriber(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "appwrite_messaging_subscriber",
+		Description: "Query subscribers of messaging topics in an appwrite project",
+		List: &plugin.ListConfig{
+			ParentHydrate: listParentTopics,
+			Hydrate:       childList(listMessagingSubscribers),
+			KeyColumns: append([]*plugin.KeyColumn{
+				{Name: "topic_id", Require: plugin.Optional},
+				{Name: "search_query", Require: plugin.Optional},
+			}, queryKeyColumns(messagingSubscriberQueryColumns)...),
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getMessagingSubscriber,
+			KeyColumns: plugin.AllColumns([]string{"topic_id", "id"}),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			// Result columns
+			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Subscriber.Id"), Description: "The unique ID of the subscriber."},
+			{Name: "title", Type: proto.ColumnType_STRING, Transform: transform.FromField("Subscriber.Id"), Description: "The ID of the subscriber."},
+			{Name: "topic_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Subscriber.TopicId"), Description: "The ID of the topic."},
+			{Name: "target_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Subscriber.TargetId"), Description: "The ID of the messaging target subscribed to the topic."},
+			{Name: "target", Type: proto.ColumnType_JSON, Transform: transform.FromField("Subscriber.Target"), Description: "The messaging target subscribed to the topic."},
+			{Name: "user_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Subscriber.UserId"), Description: "The ID of the user the target belongs to."},
+			{Name: "user_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Subscriber.UserName"), Description: "The name of the user the target belongs to."},
+			{Name: "provider_type", Type: proto.ColumnType_STRING, Transform: transform.FromField("Subscriber.ProviderType"), Description: "The type of messages the subscriber receives. Possible values are email, sms, or push."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Subscriber.CreatedAt").Transform(toTimestamp), Description: "Subscriber creation date."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Subscriber.UpdatedAt").Transform(toTimestamp), Description: "Subscriber update date."},
+
+			// Input Columns
+			{Name: "search_query", Type: proto.ColumnType_STRING, Transform: transform.FromField("Search"), Description: "The string to filter the results from the request."},
+		}),
+	}
+}
+
+var messagingSubscriberQueryColumns = []queryColumn{
+	{Name: "id", Attribute: "$id", Operators: stringOperators},
+	{Name: "target_id", Attribute: "targetId", Operators: stringOperators},
+	{Name: "user_id", Attribute: "userId", Operators: stringOperators},
+	{Name: "provider_type", Attribute: "providerType", Operators: stringOperators},
+	{Name: "created_at", Attribute: "$createdAt", Operators: numberOperators},
+	{Name: "updated_at", Attribute: "$updatedAt", Operators: numberOperators},
+}
+
+// messagingSubscriber is a subscriber of an Appwrite messaging topic.
+type messagingSubscriber struct {
+	Id           string                 `json:"$id"`
+	CreatedAt    string                 `json:"$createdAt"`
+	UpdatedAt    string                 `json:"$updatedAt"`
+	TopicId      string                 `json:"topicId"`
+	TargetId     string                 `json:"targetId"`
+	Target       map[string]interface{} `json:"target"`
+	UserId       string                 `json:"userId"`
+	UserName     string                 `json:"userName"`
+	ProviderType string                 `json:"providerType"`
+}
+
+type messagingSubscribersRow struct {
+	Subscriber messagingSubscriber
+	Search     string
+}
+
+func listMessagingSubscribers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_messaging_subscriber.listMessagingSubscribers", "connection_error", err)
+		return nil, err
+	}
+
+	topicId := h.Item.(messagingTopic).Id
+	search := d.EqualsQuals["search_query"].GetStringValue()
+
+	queries := buildQueries(d.Quals, messagingSubscriberQueryColumns)
+
+	path := apiPath("/messaging/topics/%s/subscribers", topicId)
+	err = listAll(ctx, d, conn, path, "subscribers", searchParams(search), queries, func(subscriber messagingSubscriber) {
+		row := messagingSubscribersRow{
+			Subscriber: subscriber,
+			Search:     search,
+		}
+		d.StreamListItem(ctx, row)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_messaging_subscriber.listMessagingSubscribers", "api_error", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getMessagingSubscriber(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	topicId := d.EqualsQuals["topic_id"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_messaging_subscriber.getMessagingSubscriber", "connection_error", err)
+		return nil, err
+	}
+
+	subscriber, err := getOne[messagingSubscriber](ctx, conn, "/messaging/topics/%s/subscribers/%s", topicId, id)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_messaging_subscriber.getMessagingSubscriber", "api_error", err)
+		return nil, err
+	}
+
+	return messagingSubscribersRow{Subscriber: subscriber}, nil
+}
@@ -0,0 +1,136 @@
+package appwrite
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAppwriteMessagingTopic(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "appwrite_messaging_topic",
+		Description: "Query messaging topics in an appwrite project",
+		List: &plugin.ListConfig{
+			Hydrate: listMessagingTopics,
+			KeyColumns: append([]*plugin.KeyColumn{
+				{Name: "search_query", Require: plugin.Optional},
+			}, queryKeyColumns(messagingTopicQueryColumns)...),
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getMessagingTopic,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			// Result columns
+			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Topic.Id"), Description: "The unique ID of the topic."},
+			{Name: "title", Type: proto.ColumnType_STRING, Transform: transform.FromField("Topic.Id"), Description: "The Name or ID of the topic."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Topic.Name"), Description: "The name of the topic."},
+			{Name: "email_total", Type: proto.ColumnType_INT, Transform: transform.FromField("Topic.EmailTotal"), Description: "The total number of email subscribers of the topic."},
+			{Name: "sms_total", Type: proto.ColumnType_INT, Transform: transform.FromField("Topic.SmsTotal"), Description: "The total number of SMS subscribers of the topic."},
+			{Name: "push_total", Type: proto.ColumnType_INT, Transform: transform.FromField("Topic.PushTotal"), Description: "The total number of push subscribers of the topic."},
+			{Name: "subscribe", Type: proto.ColumnType_JSON, Transform: transform.FromField("Topic.Subscribe"), Description: "The roles(list of strings) allowed to subscribe to the topic."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Topic.CreatedAt").Transform(toTimestamp), Description: "Topic creation date."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Topic.UpdatedAt").Transform(toTimestamp), Description: "Topic update date."},
+
+			// Input Columns
+			{Name: "search_query", Type: proto.ColumnType_STRING, Transform: transform.FromField("Search"), Description: "The string to filter the results from the request."},
+		}),
+	}
+}
+
+var messagingTopicQueryColumns = []queryColumn{
+	{Name: "id", Attribute: "$id", Operators: stringOperators},
+	{Name: "name", Attribute: "name", Operators: stringOperators},
+	{Name: "email_total", Attribute: "emailTotal", Operators: numberOperators},
+	{Name: "sms_total", Attribute: "smsTotal", Operators: numberOperators},
+	{Name: "push_total", Attribute: "pushTotal", Operators: numberOperators},
+	{Name: "created_at", Attribute: "$createdAt", Operators: numberOperators},
+	{Name: "updated_at", Attribute: "$updatedAt", Operators: numberOperators},
+}
+
+// messagingTopic is an Appwrite messaging topic.
+type messagingTopic struct {
+	Id         string   `json:"$id"`
+	CreatedAt  string   `json:"$createdAt"`
+	UpdatedAt  string   `json:"$updatedAt"`
+	Name       string   `json:"name"`
+	EmailTotal int64    `json:"emailTotal"`
+	SmsTotal   int64    `json:"smsTotal"`
+	PushTotal  int64    `json:"pushTotal"`
+	Subscribe  []string `json:"subscribe"`
+}
+
+type messagingTopicsRow struct {
+	Topic  messagingTopic
+	Search string
+}
+
+func listMessagingTopics(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_messaging_topic.listMessagingTopics", "connection_error", err)
+		return nil, err
+	}
+
+	search := d.EqualsQuals["search_query"].GetStringValue()
+
+	queries := buildQueries(d.Quals, messagingTopicQueryColumns)
+
+	err = listAll(ctx, d, conn, "/messaging/topics", "topics", searchParams(search), queries, func(topic messagingTopic) {
+		row := messagingTopicsRow{
+			Topic:  topic,
+			Search: search,
+		}
+		d.StreamListItem(ctx, row)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_messaging_topic.listMessagingTopics", "api_error", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getMessagingTopic(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQuals["id"].GetStringValue()
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_messaging_topic.getMessagingTopic", "connection_error", err)
+		return nil, err
+	}
+
+	topic, err := getOne[messagingTopic](ctx, conn, "/messaging/topics/%s", id)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_messaging_topic.getMessagingTopic", "api_error", err)
+		return nil, err
+	}
+
+	return messagingTopicsRow{Topic: topic}, nil
+}
+
+// listParentTopics is the parent list of tables nested under messaging
+// topics. It streams the topic given by topic_id, or every topic otherwise.
+func listParentTopics(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	if topicId := d.EqualsQuals["topic_id"].GetStringValue(); topicId != "" {
+		d.StreamListItem(ctx, messagingTopic{Id: topicId})
+		return nil, nil
+	}
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_messaging_topic.listParentTopics", "connection_error", err)
+		return nil, err
+	}
+
+	err = listAll(ctx, d, conn, "/messaging/topics", "topics", nil, nil, func(topic messagingTopic) {
+		d.StreamListItem(ctx, topic)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_messaging_topic.listParentTopics", "api_error", err)
+		return nil, err
+	}
+	return nil, nil
+}
@@ -0,0 +1,60 @@
+package appwrite
+
+import (
+	"context"
+
+	appwrite "github.com/mr-destructive/appwrite-go-sdk"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func tableAppwriteProjectVariable(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "appwrite_project_variable",
+		Description: "Query global variables shared by the functions of an appwrite project",
+		List: &plugin.ListConfig{
+			Hydrate: listProjectVariables,
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getProjectVariable,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		Columns: commonColumns(variableColumns(nil)),
+	}
+}
+
+func listProjectVariables(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_project_variable.listProjectVariables", "connection_error", err)
+		return nil, err
+	}
+
+	// Variables are returned in a single response, without pagination
+	err = listUnpaged(ctx, d, conn, "/project/variables", "variables", func(variable appwrite.Variable) {
+		d.StreamListItem(ctx, maskVariable(d, variable))
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_project_variable.listProjectVariables", "api_error", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getProjectVariable(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQuals["id"].GetStringValue()
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_project_variable.getProjectVariable", "connection_error", err)
+		return nil, err
+	}
+
+	variable, err := getOne[appwrite.Variable](ctx, conn, "/project/variables/%s", id)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_project_variable.getProjectVariable", "api_error", err)
+		return nil, err
+	}
+
+	return maskVariable(d, variable), nil
+}
@@ -0,0 +1,130 @@
+package appwrite
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAppwriteTeam(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "appwrite_team",
+		Description: "Query teams in an appwrite project",
+		List: &plugin.ListConfig{
+			Hydrate: listTeams,
+			KeyColumns: append([]*plugin.KeyColumn{
+				{Name: "search_query", Require: plugin.Optional},
+			}, queryKeyColumns(teamQueryColumns)...),
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getTeam,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			// Result columns
+			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Team.Id"), Description: "The unique ID of the team."},
+			{Name: "title", Type: proto.ColumnType_STRING, Transform: transform.FromField("Team.Name", "Team.Id"), Description: "The Name or ID of the team."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Team.Name"), Description: "The name of the team."},
+			{Name: "total", Type: proto.ColumnType_INT, Transform: transform.FromField("Team.Total"), Description: "The total number of members in the team."},
+			{Name: "prefs", Type: proto.ColumnType_JSON, Transform: transform.FromField("Team.Prefs"), Description: "The preferences of the team."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Team.CreatedAt").Transform(toTimestamp), Description: "Team creation date."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Team.UpdatedAt").Transform(toTimestamp), Description: "Team update date."},
+
+			// Input Columns
+			{Name: "search_query", Type: proto.ColumnType_STRING, Transform: transform.FromField("Search"), Description: "The string to filter the results from the request."},
+		}),
+	}
+}
+
+var teamQueryColumns = []queryColumn{
+	{Name: "id", Attribute: "$id", Operators: stringOperators},
+	{Name: "name", Attribute: "name", Operators: stringOperators},
+	{Name: "total", Attribute: "total", Operators: numberOperators},
+	{Name: "created_at", Attribute: "$createdAt", Operators: numberOperators},
+	{Name: "updated_at", Attribute: "$updatedAt", Operators: numberOperators},
+}
+
+// team is an Appwrite team, which the SDK has no type for.
+type team struct {
+	Id        string                 `json:"$id"`
+	CreatedAt string                 `json:"$createdAt"`
+	UpdatedAt string                 `json:"$updatedAt"`
+	Name      string                 `json:"name"`
+	Total     int                    `json:"total"`
+	Prefs     map[string]interface{} `json:"prefs"`
+}
+
+type teamsRow struct {
+	Team   team
+	Search string
+}
+
+func listTeams(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_team.listTeams", "connection_error", err)
+		return nil, err
+	}
+
+	search := d.EqualsQuals["search_query"].GetStringValue()
+
+	queries := buildQueries(d.Quals, teamQueryColumns)
+
+	err = listAll(ctx, d, conn, "/teams", "teams", searchParams(search), queries, func(t team) {
+		row := teamsRow{
+			Team:   t,
+			Search: search,
+		}
+		d.StreamListItem(ctx, row)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_team.listTeams", "api_error", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getTeam(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQuals["id"].GetStringValue()
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_team.getTeam", "connection_error", err)
+		return nil, err
+	}
+
+	t, err := getOne[team](ctx, conn, "/teams/%s", id)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_team.getTeam", "api_error", err)
+		return nil, err
+	}
+
+	return teamsRow{Team: t}, nil
+}
+
+// listParentTeams is the parent list of tables nested under teams. It streams
+// the team given by team_id, or every team otherwise.
+func listParentTeams(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	if teamId := d.EqualsQuals["team_id"].GetStringValue(); teamId != "" {
+		d.StreamListItem(ctx, team{Id: teamId})
+		return nil, nil
+	}
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_team.listParentTeams", "connection_error", err)
+		return nil, err
+	}
+
+	err = listAll(ctx, d, conn, "/teams", "teams", nil, nil, func(t team) {
+		d.StreamListItem(ctx, t)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_team.listParentTeams", "api_error", err)
+		return nil, err
+	}
+	return nil, nil
+}
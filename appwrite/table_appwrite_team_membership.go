@@ -0,0 +1,128 @@
+package appwrite
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAppwriteTeamMembership(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "appwrite_team_membership",
+		Description: "Query memberships of teams in an appwrite project",
+		List: &plugin.ListConfig{
+			ParentHydrate: listParentTeams,
+			Hydrate:       childList(listTeamMemberships),
+			KeyColumns: append([]*plugin.KeyColumn{
+				{Name: "team_id", Require: plugin.Optional},
+				{Name: "search_query", Require: plugin.Optional},
+			}, queryKeyColumns(teamMembershipQueryColumns)...),
+		},
+		Get: &plugin.GetConfig{
+			Hydrate:    getTeamMembership,
+			KeyColumns: plugin.AllColumns([]string{"team_id", "id"}),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			// Result columns
+			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Membership.Id"), Description: "The unique ID of the membership."},
+			{Name: "title", Type: proto.ColumnType_STRING, Transform: transform.FromField("Membership.Id"), Description: "The ID of the membership."},
+			{Name: "team_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Membership.TeamId"), Description: "The ID of the team."},
+			{Name: "team_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Membership.TeamName"), Description: "The name of the team."},
+			{Name: "user_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Membership.UserId"), Description: "The ID of the member user."},
+			{Name: "user_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Membership.UserName"), Description: "The name of the member user."},
+			{Name: "user_email", Type: proto.ColumnType_STRING, Transform: transform.FromField("Membership.UserEmail"), Description: "The email address of the member user."},
+			{Name: "roles", Type: proto.ColumnType_JSON, Transform: transform.FromField("Membership.Roles"), Description: "The roles(list of strings) of the member in the team."},
+			{Name: "invited", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Membership.Invited").Transform(toTimestamp), Description: "The date the user was invited to the team."},
+			{Name: "joined", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Membership.Joined").Transform(toTimestamp), Description: "The date the user accepted the invitation."},
+			{Name: "confirm", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Membership.Confirm"), Description: "A boolean value for checking if the user has accepted the invitation."},
+			{Name: "mfa", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Membership.Mfa"), Description: "A boolean value for checking if the user has multi factor authentication enabled."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Membership.CreatedAt").Transform(toTimestamp), Description: "Membership creation date."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Membership.UpdatedAt").Transform(toTimestamp), Description: "Membership update date."},
+
+			// Input Columns
+			{Name: "search_query", Type: proto.ColumnType_STRING, Transform: transform.FromField("Search"), Description: "The string to filter the results from the request."},
+		}),
+	}
+}
+
+var teamMembershipQueryColumns = []queryColumn{
+	{Name: "id", Attribute: "$id", Operators: stringOperators},
+	{Name: "user_id", Attribute: "userId", Operators: stringOperators},
+	{Name: "invited", Attribute: "invited", Operators: numberOperators},
+	{Name: "joined", Attribute: "joined", Operators: numberOperators},
+	{Name: "confirm", Attribute: "confirm", Operators: boolOperators},
+	{Name: "created_at", Attribute: "$createdAt", Operators: numberOperators},
+	{Name: "updated_at", Attribute: "$updatedAt", Operators: numberOperators},
+}
+
+// teamMembership is a membership of an Appwrite team, which the SDK has no
+// type for.
+type teamMembership struct {
+	Id        string   `json:"$id"`
+	CreatedAt string   `json:"$createdAt"`
+	UpdatedAt string   `json:"$updatedAt"`
+	UserId    string   `json:"userId"`
+	UserName  string   `json:"userName"`
+	UserEmail string   `json:"userEmail"`
+	TeamId    string   `json:"teamId"`
+	TeamName  string   `json:"teamName"`
+	Invited   string   `json:"invited"`
+	Joined    string   `json:"joined"`
+	Confirm   bool     `json:"confirm"`
+	Mfa       bool     `json:"mfa"`
+	Roles     []string `json:"roles"`
+}
+
+type teamMembershipsRow struct {
+	Membership teamMembership
+	Search     string
+}
+
+func listTeamMemberships(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_team_membership.listTeamMemberships", "connection_error", err)
+		return nil, err
+	}
+
+	teamId := h.Item.(team).Id
+	search := d.EqualsQuals["search_query"].GetStringValue()
+
+	queries := buildQueries(d.Quals, teamMembershipQueryColumns)
+
+	path := apiPath("/teams/%s/memberships", teamId)
+	err = listAll(ctx, d, conn, path, "memberships", searchParams(search), queries, func(membership teamMembership) {
+		row := teamMembershipsRow{
+			Membership: membership,
+			Search:     search,
+		}
+		d.StreamListItem(ctx, row)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_team_membership.listTeamMemberships", "api_error", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getTeamMembership(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	teamId := d.EqualsQuals["team_id"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_team_membership.getTeamMembership", "connection_error", err)
+		return nil, err
+	}
+
+	membership, err := getOne[teamMembership](ctx, conn, "/teams/%s/memberships/%s", teamId, id)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_team_membership.getTeamMembership", "api_error", err)
+		return nil, err
+	}
+
+	return teamMembershipsRow{Membership: membership}, nil
+}
@@ -0,0 +1,34 @@
+package appwrite
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAppwriteUsageDatabase(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "appwrite_usage_database",
+		Description: "Query usage metrics of the databases of an appwrite project, e.g. collection and document counts",
+		List: &plugin.ListConfig{
+			Hydrate: listUsageDatabase,
+			KeyColumns: usageKeyColumns(
+				&plugin.KeyColumn{Name: "database_id", Require: plugin.Optional},
+			),
+		},
+		Columns: commonColumns(usageColumns([]*plugin.Column{
+			{Name: "database_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ResourceId").Transform(transform.NullIfZeroValue), Description: "The unique ID of the database to get the usage of. Null for the usage of every database."},
+		})),
+	}
+}
+
+func listUsageDatabase(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	err := listResourceUsage(ctx, d, "database_id", "/databases/usage", "/databases/%s/usage")
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_usage_database.listUsageDatabase", "api_error", err)
+		return nil, err
+	}
+	return nil, nil
+}
@@ -0,0 +1,34 @@
+package appwrite
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAppwriteUsageFunction(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "appwrite_usage_function",
+		Description: "Query usage metrics of the functions of an appwrite project, e.g. executions and compute time",
+		List: &plugin.ListConfig{
+			Hydrate: listUsageFunction,
+			KeyColumns: usageKeyColumns(
+				&plugin.KeyColumn{Name: "function_id", Require: plugin.Optional},
+			),
+		},
+		Columns: commonColumns(usageColumns([]*plugin.Column{
+			{Name: "function_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ResourceId").Transform(transform.NullIfZeroValue), Description: "The unique ID of the function to get the usage of. Null for the usage of every function."},
+		})),
+	}
+}
+
+func listUsageFunction(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	err := listResourceUsage(ctx, d, "function_id", "/functions/usage", "/functions/%s/usage")
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_usage_function.listUsageFunction", "api_error", err)
+		return nil, err
+	}
+	return nil, nil
+}
@@ -0,0 +1,34 @@
+package appwrite
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAppwriteUsageStorage(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "appwrite_usage_storage",
+		Description: "Query usage metrics of the storage of an appwrite project, e.g. file counts and storage bytes",
+		List: &plugin.ListConfig{
+			Hydrate: listUsageStorage,
+			KeyColumns: usageKeyColumns(
+				&plugin.KeyColumn{Name: "bucket_id", Require: plugin.Optional},
+			),
+		},
+		Columns: commonColumns(usageColumns([]*plugin.Column{
+			{Name: "bucket_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ResourceId").Transform(transform.NullIfZeroValue), Description: "The unique ID of the bucket to get the usage of. Null for the usage of every bucket."},
+		})),
+	}
+}
+
+func listUsageStorage(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	err := listResourceUsage(ctx, d, "bucket_id", "/storage/usage", "/storage/%s/usage")
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_usage_storage.listUsageStorage", "api_error", err)
+		return nil, err
+	}
+	return nil, nil
+}
@@ -0,0 +1,40 @@
+package appwrite
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+func tableAppwriteUsageUser(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "appwrite_usage_user",
+		Description: "Query usage metrics of the users of an appwrite project, e.g. user and session counts",
+		List: &plugin.ListConfig{
+			Hydrate:    listUsageUser,
+			KeyColumns: usageKeyColumns(),
+		},
+		Columns: commonColumns(usageColumns(nil)),
+	}
+}
+
+func listUsageUser(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	usage, err := usageRange(d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_usage_user.listUsageUser", "invalid_range", err)
+		return nil, err
+	}
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_usage_user.listUsageUser", "connection_error", err)
+		return nil, err
+	}
+
+	err = listUsage(ctx, d, conn, "/users/usage", usage, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_usage_user.listUsageUser", "api_error", err)
+		return nil, err
+	}
+	return nil, nil
+}
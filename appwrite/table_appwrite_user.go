@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 
-	appwrite "github.com/mr-destructive/appwrite-go-sdk"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
@@ -16,12 +15,16 @@ func tableAppwriteUser(ctx context.Context) *plugin.Table {
 		Description: "Query users in an appwrite project",
 		List: &plugin.ListConfig{
 			Hydrate: listUsers,
-			KeyColumns: []*plugin.KeyColumn{
+			KeyColumns: append([]*plugin.KeyColumn{
 				{Name: "search_query", Require: plugin.Optional},
 				{Name: "settings", Require: plugin.Optional},
-			},
+			}, queryKeyColumns(userQueryColumns)...),
 		},
-		Columns: []*plugin.Column{
+		Get: &plugin.GetConfig{
+			Hydrate:    getUser,
+			KeyColumns: plugin.SingleColumn("id"),
+		},
+		Columns: commonColumns([]*plugin.Column{
 			// Result columns
 			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "The unique ID of the account user."},
 			{Name: "title", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "The Name or ID of the user."},
@@ -29,14 +32,24 @@ func tableAppwriteUser(ctx context.Context) *plugin.Table {
 			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "The Name of the account user."},
 			{Name: "status", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Status"), Description: "The active status of the account user."},
 			{Name: "phone", Type: proto.ColumnType_STRING, Transform: transform.FromField("Phone"), Description: "The phone number of the account user."},
-			{Name: "password", Type: proto.ColumnType_STRING, Transform: transform.FromField("Phone"), Description: "The password of the account user."},
 			{Name: "email_verification", Type: proto.ColumnType_BOOL, Transform: transform.FromField("EmailVerification"), Description: "The status of the email verification of the account user."},
 			{Name: "phone_verification", Type: proto.ColumnType_BOOL, Transform: transform.FromField("PhoneVerification"), Description: "The status of the phone verification of the account user."},
+			{Name: "mfa", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Mfa"), Description: "A boolean value for checking if multi factor authentication is enabled for the account user."},
+			{Name: "labels", Type: proto.ColumnType_JSON, Transform: transform.FromField("Labels"), Description: "The labels(list of strings) of the account user."},
+			{Name: "prefs", Type: proto.ColumnType_JSON, Transform: transform.FromField("Prefs"), Description: "The preferences of the account user."},
+			{Name: "targets", Type: proto.ColumnType_JSON, Transform: transform.FromField("Targets"), Description: "The messaging targets(email, phone or push) of the account user."},
+			{Name: "hash_algorithm", Type: proto.ColumnType_STRING, Transform: transform.FromField("Hash"), Description: "The algorithm used to hash the password of the account user, e.g. argon2. The hash itself is never exposed."},
+			{Name: "hash_options", Type: proto.ColumnType_JSON, Transform: transform.FromField("HashOptions").Transform(hashCostOptions), Description: "The cost parameters of the password hashing algorithm of the account user, e.g. the memory and time cost of argon2. Salts and signer keys are never exposed."},
+			{Name: "registration", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Registration").Transform(toTimestamp), Description: "User registration date."},
+			{Name: "password_update", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("PasswordUpdate").Transform(toTimestamp), Description: "The date the password of the account user was last updated."},
+			{Name: "accessed_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("AccessedAt").Transform(toTimestamp), Description: "The date the account user was last active, updated at most once every 24 hours."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("CreatedAt").Transform(toTimestamp), Description: "User creation date."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("UpdatedAt").Transform(toTimestamp), Description: "User update date."},
 
 			// Input Columns
 			{Name: "search_query", Type: proto.ColumnType_STRING, Transform: transform.FromField("Search"), Description: "The string as a search filter the results from the request."},
 			{Name: "settings", Type: proto.ColumnType_JSON, Transform: transform.FromQual("settings"), Description: "Settings is a JSONB object that accepts any of the completion API request parameters."},
-		},
+		}),
 	}
 }
 
@@ -44,8 +57,46 @@ type usersRequestQual struct {
 	Search *string `json:"search_query"`
 }
 
+var userQueryColumns = []queryColumn{
+	{Name: "id", Attribute: "$id", Operators: stringOperators},
+	{Name: "name", Attribute: "name", Operators: stringOperators},
+	{Name: "email", Attribute: "email", Operators: stringOperators},
+	{Name: "phone", Attribute: "phone", Operators: stringOperators},
+	{Name: "status", Attribute: "status", Operators: boolOperators},
+	{Name: "email_verification", Attribute: "emailVerification", Operators: boolOperators},
+	{Name: "phone_verification", Attribute: "phoneVerification", Operators: boolOperators},
+	{Name: "registration", Attribute: "registration", Operators: numberOperators},
+	{Name: "password_update", Attribute: "passwordUpdate", Operators: numberOperators},
+	{Name: "created_at", Attribute: "$createdAt", Operators: numberOperators},
+	{Name: "updated_at", Attribute: "$updatedAt", Operators: numberOperators},
+}
+
+// user is an Appwrite user. Unlike the SDK's UserObject it leaves out the
+// password hash, so that it can never be exposed. Appwrite's hash field holds
+// the name of the hashing algorithm.
+type user struct {
+	Id                string                   `json:"$id"`
+	CreatedAt         string                   `json:"$createdAt"`
+	UpdatedAt         string                   `json:"$updatedAt"`
+	Name              string                   `json:"name"`
+	Email             string                   `json:"email"`
+	Phone             string                   `json:"phone"`
+	Status            bool                     `json:"status"`
+	EmailVerification bool                     `json:"emailVerification"`
+	PhoneVerification bool                     `json:"phoneVerification"`
+	Mfa               bool                     `json:"mfa"`
+	Labels            []string                 `json:"labels"`
+	Prefs             map[string]interface{}   `json:"prefs"`
+	Targets           []map[string]interface{} `json:"targets"`
+	Hash              string                   `json:"hash"`
+	HashOptions       map[string]interface{}   `json:"hashOptions"`
+	Registration      string                   `json:"registration"`
+	PasswordUpdate    string                   `json:"passwordUpdate"`
+	AccessedAt        string                   `json:"accessedAt"`
+}
+
 type usersRow struct {
-	appwrite.UserObject
+	user
 	Search string
 }
 
@@ -69,21 +120,77 @@ func listUsers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 		}
 	}
 
-	users := appwrite.Users{
-		Client: *conn,
-	}
 	search := d.EqualsQuals["search_query"].GetStringValue()
 
-	userList, err := users.List(search, 0, 0, "")
+	queries := buildQueries(d.Quals, userQueryColumns)
+
+	err = listAll(ctx, d, conn, "/users", "users", searchParams(search), queries, func(u user) {
+		row := usersRow{u, search}
+		d.StreamListItem(ctx, row)
+	})
 	if err != nil {
 		plugin.Logger(ctx).Error("appwrite_user.listUsers", "api_error", err)
 		return nil, err
 	}
-	plugin.Logger(ctx).Trace("appwrite_user.listUsers", "response", userList)
-	for _, u := range userList {
-		row := usersRow{u, search}
-		d.StreamListItem(ctx, row)
+
+	return nil, nil
+}
+
+func getUser(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQuals["id"].GetStringValue()
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_user.getUser", "connection_error", err)
+		return nil, err
 	}
 
+	u, err := getOne[user](ctx, conn, "/users/%s", id)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_user.getUser", "api_error", err)
+		return nil, err
+	}
+
+	return usersRow{user: u}, nil
+}
+
+// listParentUsers is the parent list of tables nested under users. It streams
+// the user given by user_id, or every user otherwise.
+func listParentUsers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	if userId := d.EqualsQuals["user_id"].GetStringValue(); userId != "" {
+		d.StreamListItem(ctx, user{Id: userId})
+		return nil, nil
+	}
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_user.listParentUsers", "connection_error", err)
+		return nil, err
+	}
+
+	err = listAll(ctx, d, conn, "/users", "users", nil, nil, func(u user) {
+		d.StreamListItem(ctx, u)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_user.listParentUsers", "api_error", err)
+		return nil, err
+	}
 	return nil, nil
 }
+
+// hashCostOptions lists the hash options that are safe to expose. Appwrite
+// also returns the salt, salt separator and signer key of scrypt hashes, which
+// are secrets.
+func hashCostOptions(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	options, ok := d.Value.(map[string]interface{})
+	if !ok || options == nil {
+		return nil, nil
+	}
+	costs := map[string]interface{}{}
+	for _, key := range []string{"type", "version", "memoryCost", "timeCost", "threads", "cost", "costCpu", "costMemory", "costParallel", "length"} {
+		if value, ok := options[key]; ok {
+			costs[key] = value
+		}
+	}
+	return costs, nil
+}
@@ -0,0 +1,95 @@
+package appwrite
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/quals"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAppwriteUserIdentity(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "appwrite_user_identity",
+		Description: "Query OAuth2 identities of users in an appwrite project",
+		List: &plugin.ListConfig{
+			Hydrate: listUserIdentities,
+			KeyColumns: append([]*plugin.KeyColumn{
+				{Name: "search_query", Require: plugin.Optional},
+			}, queryKeyColumns(userIdentityQueryColumns)...),
+		},
+		Columns: commonColumns([]*plugin.Column{
+			// Result columns
+			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Identity.Id"), Description: "The unique ID of the identity."},
+			{Name: "title", Type: proto.ColumnType_STRING, Transform: transform.FromField("Identity.Id"), Description: "The ID of the identity."},
+			{Name: "user_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Identity.UserId"), Description: "The ID of the user the identity belongs to."},
+			{Name: "provider", Type: proto.ColumnType_STRING, Transform: transform.FromField("Identity.Provider"), Description: "The OAuth2 provider of the identity, e.g. github."},
+			{Name: "provider_uid", Type: proto.ColumnType_STRING, Transform: transform.FromField("Identity.ProviderUid"), Description: "The ID of the user at the OAuth2 provider."},
+			{Name: "provider_email", Type: proto.ColumnType_STRING, Transform: transform.FromField("Identity.ProviderEmail"), Description: "The email address of the user at the OAuth2 provider."},
+			{Name: "provider_access_token_expiry", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Identity.ProviderAccessTokenExpiry").Transform(toTimestamp), Description: "The expiration date of the OAuth2 access token."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Identity.CreatedAt").Transform(toTimestamp), Description: "Identity creation date."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Identity.UpdatedAt").Transform(toTimestamp), Description: "Identity update date."},
+
+			// Input Columns
+			{Name: "search_query", Type: proto.ColumnType_STRING, Transform: transform.FromField("Search"), Description: "The string to filter the results from the request."},
+		}),
+	}
+}
+
+var userIdentityQueryColumns = []queryColumn{
+	{Name: "id", Attribute: "$id", Operators: stringOperators},
+	{Name: "user_id", Attribute: "userId", Operators: []string{quals.QualOperatorEqual, quals.QualOperatorNotEqual}},
+	{Name: "provider", Attribute: "provider", Operators: stringOperators},
+	{Name: "provider_uid", Attribute: "providerUid", Operators: stringOperators},
+	{Name: "provider_email", Attribute: "providerEmail", Operators: stringOperators},
+	{Name: "provider_access_token_expiry", Attribute: "providerAccessTokenExpiry", Operators: numberOperators},
+	{Name: "created_at", Attribute: "$createdAt", Operators: numberOperators},
+	{Name: "updated_at", Attribute: "$updatedAt", Operators: numberOperators},
+}
+
+// userIdentity is an OAuth2 identity of an Appwrite user. The provider tokens
+// Appwrite returns with an identity are deliberately left out.
+type userIdentity struct {
+	Id                        string `json:"$id"`
+	CreatedAt                 string `json:"$createdAt"`
+	UpdatedAt                 string `json:"$updatedAt"`
+	UserId                    string `json:"userId"`
+	Provider                  string `json:"provider"`
+	ProviderUid               string `json:"providerUid"`
+	ProviderEmail             string `json:"providerEmail"`
+	ProviderAccessTokenExpiry string `json:"providerAccessTokenExpiry"`
+}
+
+type userIdentitiesRow struct {
+	Identity userIdentity
+	Search   string
+}
+
+func listUserIdentities(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_user_identity.listUserIdentities", "connection_error", err)
+		return nil, err
+	}
+
+	search := d.EqualsQuals["search_query"].GetStringValue()
+
+	// Identities of every user are listed together, so user_id is filtered
+	// with a query rather than a path
+	queries := buildQueries(d.Quals, userIdentityQueryColumns)
+
+	err = listAll(ctx, d, conn, "/users/identities", "identities", searchParams(search), queries, func(identity userIdentity) {
+		row := userIdentitiesRow{
+			Identity: identity,
+			Search:   search,
+		}
+		d.StreamListItem(ctx, row)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_user_identity.listUserIdentities", "api_error", err)
+		return nil, err
+	}
+	return nil, nil
+}
@@ -0,0 +1,98 @@
+package appwrite
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableAppwriteUserSession(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "appwrite_user_session",
+		Description: "Query login sessions of users in an appwrite project",
+		List: &plugin.ListConfig{
+			ParentHydrate: listParentUsers,
+			Hydrate:       childList(listUserSessions),
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "user_id", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			// Result columns
+			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "The unique ID of the session."},
+			{Name: "title", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "The ID of the session."},
+			{Name: "user_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("UserId"), Description: "The ID of the user the session belongs to."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("CreatedAt").Transform(toTimestamp), Description: "Session creation date."},
+			{Name: "expire", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Expire").Transform(toTimestamp), Description: "Session expiration date."},
+			{Name: "provider", Type: proto.ColumnType_STRING, Transform: transform.FromField("Provider"), Description: "The session provider, e.g. email, anonymous or an OAuth2 provider."},
+			{Name: "provider_uid", Type: proto.ColumnType_STRING, Transform: transform.FromField("ProviderUid"), Description: "The ID of the user at the session provider."},
+			{Name: "ip", Type: proto.ColumnType_IPADDR, Transform: transform.FromField("Ip"), Description: "The IP address the session was created from."},
+			{Name: "os_code", Type: proto.ColumnType_STRING, Transform: transform.FromField("OsCode"), Description: "The operating system code name."},
+			{Name: "os_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("OsName"), Description: "The operating system name."},
+			{Name: "os_version", Type: proto.ColumnType_STRING, Transform: transform.FromField("OsVersion"), Description: "The operating system version."},
+			{Name: "client_type", Type: proto.ColumnType_STRING, Transform: transform.FromField("ClientType"), Description: "The client type, e.g. browser or mobile app."},
+			{Name: "client_code", Type: proto.ColumnType_STRING, Transform: transform.FromField("ClientCode"), Description: "The client code name."},
+			{Name: "client_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("ClientName"), Description: "The client name."},
+			{Name: "client_version", Type: proto.ColumnType_STRING, Transform: transform.FromField("ClientVersion"), Description: "The client version."},
+			{Name: "client_engine", Type: proto.ColumnType_STRING, Transform: transform.FromField("ClientEngine"), Description: "The client engine name."},
+			{Name: "client_engine_version", Type: proto.ColumnType_STRING, Transform: transform.FromField("ClientEngineVersion"), Description: "The client engine version."},
+			{Name: "device_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("DeviceName"), Description: "The device name."},
+			{Name: "device_brand", Type: proto.ColumnType_STRING, Transform: transform.FromField("DeviceBrand"), Description: "The device brand."},
+			{Name: "device_model", Type: proto.ColumnType_STRING, Transform: transform.FromField("DeviceModel"), Description: "The device model."},
+			{Name: "country_code", Type: proto.ColumnType_STRING, Transform: transform.FromField("CountryCode"), Description: "The country code of the session IP address in ISO 3166-1 two-character format."},
+			{Name: "country_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("CountryName"), Description: "The country name of the session IP address."},
+			{Name: "current", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Current"), Description: "A boolean value for checking if the session is the one making the request."},
+		}),
+	}
+}
+
+// userSession is a login session of an Appwrite user. The provider tokens
+// Appwrite returns with a session are deliberately left out.
+type userSession struct {
+	Id                  string `json:"$id"`
+	CreatedAt           string `json:"$createdAt"`
+	UserId              string `json:"userId"`
+	Expire              string `json:"expire"`
+	Provider            string `json:"provider"`
+	ProviderUid         string `json:"providerUid"`
+	Ip                  string `json:"ip"`
+	OsCode              string `json:"osCode"`
+	OsName              string `json:"osName"`
+	OsVersion           string `json:"osVersion"`
+	ClientType          string `json:"clientType"`
+	ClientCode          string `json:"clientCode"`
+	ClientName          string `json:"clientName"`
+	ClientVersion       string `json:"clientVersion"`
+	ClientEngine        string `json:"clientEngine"`
+	ClientEngineVersion string `json:"clientEngineVersion"`
+	DeviceName          string `json:"deviceName"`
+	DeviceBrand         string `json:"deviceBrand"`
+	DeviceModel         string `json:"deviceModel"`
+	CountryCode         string `json:"countryCode"`
+	CountryName         string `json:"countryName"`
+	Current             bool   `json:"current"`
+}
+
+func listUserSessions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_user_session.listUserSessions", "connection_error", err)
+		return nil, err
+	}
+
+	userId := h.Item.(user).Id
+
+	// Sessions are returned in a single response, without pagination
+	path := apiPath("/users/%s/sessions", userId)
+	err = listUnpaged(ctx, d, conn, path, "sessions", func(session userSession) {
+		d.StreamListItem(ctx, session)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("appwrite_user_session.listUserSessions", "api_error", err)
+		return nil, err
+	}
+	return nil, nil
+}
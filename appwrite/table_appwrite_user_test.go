@@ -0,0 +1,27 @@
+package appwrite
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func TestHashCostOptionsHidesSecrets(t *testing.T) {
+	options := map[string]interface{}{
+		"type":          "scryptMod",
+		"salt":          "c2FsdA==",
+		"saltSeparator": "Bw==",
+		"signerKey":     "c2lnbmVy",
+		"costCpu":       8,
+		"length":        64,
+	}
+	got, err := hashCostOptions(testContext(), &transform.TransformData{Value: options})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{"type": "scryptMod", "costCpu": 8, "length": 64}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("hash_options = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,137 @@
+package appwrite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// usageRanges are the periods Appwrite reports usage for.
+var usageRanges = []string{"24h", "30d", "90d"}
+
+// defaultUsageRange is the period reported when no range is given.
+const defaultUsageRange = "30d"
+
+// usageRow is a point of a usage metric's time series or, without a date, the
+// metric's total.
+type usageRow struct {
+	Range      string
+	ResourceId string
+	Metric     string
+	Date       string
+	Value      int64
+}
+
+// usageColumns returns the columns of a usage table, followed by the table's
+// own columns.
+func usageColumns(columns []*plugin.Column) []*plugin.Column {
+	return append([]*plugin.Column{
+		{Name: "metric", Type: proto.ColumnType_STRING, Transform: transform.FromField("Metric"), Description: "The name of the metric, e.g. documents. Totals over the whole lifetime end in Total, e.g. documentsTotal."},
+		{Name: "date", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Date").Transform(toTimestamp), Description: "The start of the period of the point. Null for totals."},
+		{Name: "value", Type: proto.ColumnType_INT, Transform: transform.FromField("Value"), Description: "The value of the metric for the period, or its total."},
+
+		// Input Columns
+		{Name: "range", Type: proto.ColumnType_STRING, Transform: transform.FromField("Range"), Description: "The period of the time series as one of 24h, 30d or 90d. Defaults to 30d."},
+	}, columns...)
+}
+
+// usageKeyColumns returns the key columns of a usage table, followed by the
+// table's own key columns.
+func usageKeyColumns(keyColumns ...*plugin.KeyColumn) []*plugin.KeyColumn {
+	return append([]*plugin.KeyColumn{
+		{Name: "range", Require: plugin.Optional},
+	}, keyColumns...)
+}
+
+// usageRange returns the range qual, defaulting to defaultUsageRange.
+func usageRange(d *plugin.QueryData) (string, error) {
+	usage := d.EqualsQuals["range"].GetStringValue()
+	if usage == "" {
+		return defaultUsageRange, nil
+	}
+	for _, valid := range usageRanges {
+		if usage == valid {
+			return usage, nil
+		}
+	}
+	return "", fmt.Errorf("unknown usage range %q, expected one of 24h, 30d or 90d", usage)
+}
+
+// listUsage streams the metrics of the usage endpoint at path, a row per point
+// of each time series followed by a row per total.
+func listUsage(ctx context.Context, d *plugin.QueryData, conn *appwriteClient, path string, usage string, resourceId string) error {
+	resp, err := conn.get(ctx, path, url.Values{"range": {usage}})
+	if err != nil {
+		return err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(resp, &fields); err != nil {
+		return err
+	}
+
+	// Sort the metrics, so that they're listed in a stable order
+	metrics := make([]string, 0, len(fields))
+	for metric := range fields {
+		if metric != "range" {
+			metrics = append(metrics, metric)
+		}
+	}
+	sort.Strings(metrics)
+
+	var totals []usageRow
+	for _, metric := range metrics {
+		var points []struct {
+			Value int64  `json:"value"`
+			Date  string `json:"date"`
+		}
+		if err := json.Unmarshal(fields[metric], &points); err == nil {
+			for _, point := range points {
+				d.StreamListItem(ctx, usageRow{Range: usage, ResourceId: resourceId, Metric: metric, Date: point.Date, Value: point.Value})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil
+				}
+			}
+			continue
+		}
+		var total int64
+		if err := json.Unmarshal(fields[metric], &total); err == nil {
+			totals = append(totals, usageRow{Range: usage, ResourceId: resourceId, Metric: metric, Value: total})
+		}
+	}
+	for _, total := range totals {
+		d.StreamListItem(ctx, total)
+		if d.RowsRemaining(ctx) == 0 {
+			return nil
+		}
+	}
+	return nil
+}
+
+// listResourceUsage streams the usage of the resource given by the idColumn
+// qual from the endpoint at resourcePath, formatted with the resource's ID, or
+// the project-wide usage from projectPath otherwise.
+func listResourceUsage(ctx context.Context, d *plugin.QueryData, idColumn string, projectPath string, resourcePath string) error {
+	usage, err := usageRange(d)
+	if err != nil {
+		return err
+	}
+
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return err
+	}
+
+	id := d.EqualsQuals[idColumn].GetStringValue()
+	if id == "" {
+		return listUsage(ctx, d, conn, projectPath, usage, "")
+	}
+	return listUsage(ctx, d, conn, apiPath(resourcePath, id), usage, id)
+}
@@ -2,57 +2,510 @@ package appwrite
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
 	"os"
 	"strings"
+	"sync"
+	"time"
 
-	appwrite "github.com/mr-destructive/appwrite-go-sdk"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
 
-func connect(ctx context.Context, d *plugin.QueryData) (*appwrite.Client, error) {
+func connect(ctx context.Context, d *plugin.QueryData) (*appwriteClient, error) {
 
-	cacheKey := "appwrite"
-	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
-		return cachedData.(*appwrite.Client), nil
+	settings, err := resolveSettings(GetConfig(d.Connection))
+	if err != nil {
+		return nil, err
+	}
+
+	// Clients are cached per connection, endpoint and project so that
+	// connections never share a client, and a changed config builds a new one
+	cacheKey := fmt.Sprintf("appwrite-%s-%s-%s", d.Connection.Name, settings.Endpoint, settings.ProjectID)
+	if cachedData, ok := d.ConnectionCache.Get(ctx, cacheKey); ok {
+		return cachedData.(*appwriteClient), nil
 	}
 
-	conn, err := connectCached(ctx, d, nil)
+	conn := settings.client()
+	if err := d.ConnectionCache.Set(ctx, cacheKey, conn); err != nil {
+		plugin.Logger(ctx).Error("connect", "cache_set_error", err)
+	}
+	return conn, nil
+}
+
+// newClient builds an Appwrite client from the connection config, falling
+// back to environment variables for any unset settings.
+func newClient(appwriteConfig appwriteConfig) (*appwriteClient, error) {
+	settings, err := resolveSettings(appwriteConfig)
 	if err != nil {
 		return nil, err
 	}
-	return conn.(*appwrite.Client), nil
+	return settings.client(), nil
+}
+
+// clientSettings are the resolved settings used to build a client.
+type clientSettings struct {
+	Endpoint  string
+	ProjectID string
+	SecretKey string
+}
+
+func (s clientSettings) client() *appwriteClient {
+	return &appwriteClient{
+		settings:   s,
+		httpClient: &http.Client{Timeout: requestTimeout},
+		childLists: make(chan struct{}, maxChildListConcurrency),
+	}
+}
+
+// requestTimeout bounds how long a request to Appwrite may take, so that a
+// hung server fails the query rather than blocking it.
+const requestTimeout = time.Minute
+
+// responseFormat pins the version of Appwrite's response models, so that newer
+// servers answer in the format the tables map. Queries are sent in the JSON
+// format of the same version, so Appwrite 1.5 or later is required.
+const responseFormat = "1.5.0"
+
+// maxRateLimitWait caps how long a request waits for a rate limit to reset.
+const maxRateLimitWait = time.Minute
+
+// appwriteClient calls the Appwrite REST API directly, as the SDK drops the
+// status and headers of error responses.
+type appwriteClient struct {
+	settings   clientSettings
+	httpClient *http.Client
+
+	// rateLimitReset is when the rate limit last hit by the client resets.
+	// Requests wait for it, so that a retry after a shorter backoff doesn't
+	// hit the limit again.
+	rateLimitMu    sync.Mutex
+	rateLimitReset time.Time
+
+	// childLists bounds the child lists running at once on the connection,
+	// see childList.
+	childLists chan struct{}
+}
+
+// waitForRateLimit waits until the rate limit last hit by the client resets.
+func (c *appwriteClient) waitForRateLimit(ctx context.Context) error {
+	c.rateLimitMu.Lock()
+	wait := time.Until(c.rateLimitReset)
+	c.rateLimitMu.Unlock()
+	if wait <= 0 {
+		return nil
+	}
+	if wait > maxRateLimitWait {
+		wait = maxRateLimitWait
+	}
+	plugin.Logger(ctx).Debug("appwriteClient.waitForRateLimit", "wait", wait)
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
-var connectCached = plugin.HydrateFunc(connectUncached).Memoize()
+// get requests path from the Appwrite API and returns the response body. Error
+// responses are returned as an *appwriteError.
+func (c *appwriteClient) get(ctx context.Context, path string, params url.Values) ([]byte, error) {
+	if err := c.waitForRateLimit(ctx); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.settings.Endpoint+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.URL.RawQuery = params.Encode()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Appwrite-Project", c.settings.ProjectID)
+	req.Header.Set("X-Appwrite-Key", c.settings.SecretKey)
+	req.Header.Set("X-Appwrite-Response-Format", responseFormat)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
 
-func connectUncached(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (any, error) {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		apiErr := newAppwriteError(resp, body)
+		if apiErr.Code == http.StatusTooManyRequests {
+			c.rateLimitMu.Lock()
+			if apiErr.RateLimitReset.After(c.rateLimitReset) {
+				c.rateLimitReset = apiErr.RateLimitReset
+			}
+			c.rateLimitMu.Unlock()
+		}
+		return nil, apiErr
+	}
+	return body, nil
+}
 
-	conn := &appwrite.Client{}
+func resolveSettings(appwriteConfig appwriteConfig) (clientSettings, error) {
 
 	// Default to the env var settings
-	secretKey := os.Getenv("APPWRITE_SECRET_KEY")
-	projectID := os.Getenv("APPWRITE_PROJECT_ID")
+	settings := clientSettings{
+		Endpoint:  os.Getenv("APPWRITE_ENDPOINT"),
+		ProjectID: os.Getenv("APPWRITE_PROJECT_ID"),
+		SecretKey: os.Getenv("APPWRITE_SECRET_KEY"),
+	}
 
 	// Prefer config settings
-	appwriteConfig := GetConfig(d.Connection)
-	if appwriteConfig.SecretKey != nil || appwriteConfig.ProjectID != nil {
-		secretKey = *appwriteConfig.SecretKey
-		projectID = *appwriteConfig.ProjectID
+	if appwriteConfig.SecretKey != nil {
+		settings.SecretKey = *appwriteConfig.SecretKey
+	}
+	if appwriteConfig.ProjectID != nil {
+		settings.ProjectID = *appwriteConfig.ProjectID
+	}
+	if appwriteConfig.Endpoint != nil {
+		settings.Endpoint = *appwriteConfig.Endpoint
 	}
 
 	// Error if the minimum config is not set
-	if secretKey == "" || projectID == "" {
-		return conn, errors.New("api_key must be configured")
+	if settings.SecretKey == "" || settings.ProjectID == "" {
+		return settings, errors.New("secret_key and project_id must be configured")
 	}
 
-	conn.SetEndpoint("https://cloud.appwrite.io/v1")
-	conn.SetKey(secretKey)
-	conn.SetProject(projectID)
+	// Default to Appwrite Cloud when no endpoint is configured
+	if settings.Endpoint == "" {
+		settings.Endpoint = defaultEndpoint
+	}
+	endpoint, err := validateEndpoint(settings.Endpoint)
+	if err != nil {
+		return settings, err
+	}
+	settings.Endpoint = endpoint
 
-	return conn, nil
+	return settings, nil
 }
 
-func isNotFoundError(err error) bool {
-	return strings.Contains(err.Error(), "status code: 404")
+const defaultEndpoint = "https://cloud.appwrite.io/v1"
+
+// validateEndpoint checks that endpoint is an absolute http(s) URL and
+// returns it without a trailing slash, as the SDK appends paths verbatim.
+func validateEndpoint(endpoint string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid endpoint %q: %w", endpoint, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("invalid endpoint %q: must be an absolute http or https URL, e.g. https://cloud.appwrite.io/v1", endpoint)
+	}
+	return strings.TrimRight(endpoint, "/"), nil
+}
+
+// commonColumns adds the columns shared by every table, so that rows from
+// aggregated connections can be told apart.
+func commonColumns(columns []*plugin.Column) []*plugin.Column {
+	return append(columns, &plugin.Column{
+		Name:        "project_id",
+		Type:        proto.ColumnType_STRING,
+		Hydrate:     getProjectId,
+		Transform:   transform.FromValue(),
+		Description: "The ID of the Appwrite project the row belongs to.",
+	})
+}
+
+// appwriteTimeLayouts are the layouts Appwrite writes datetimes in, e.g.
+// 2023-08-18T13:21:32.123+00:00, or without a zone in some older responses.
+var appwriteTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999",
+}
+
+// parseAppwriteTime parses an Appwrite datetime. Datetimes without a zone are
+// in UTC.
+func parseAppwriteTime(value string) (time.Time, bool) {
+	for _, layout := range appwriteTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, time.UTC); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// toTimestamp transforms an Appwrite datetime string into a time for a
+// TIMESTAMP column. Empty values, which Appwrite returns for datetimes that
+// were never set, and unparseable values become null.
+func toTimestamp(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	value, ok := d.Value.(string)
+	if !ok || strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	t, ok := parseAppwriteTime(strings.TrimSpace(value))
+	if !ok {
+		plugin.Logger(ctx).Warn("toTimestamp", "column", d.ColumnName, "invalid_datetime", value)
+		return nil, nil
+	}
+	return t, nil
+}
+
+func getProjectId(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("getProjectId", "connection_error", err)
+		return nil, err
+	}
+	return conn.settings.ProjectID, nil
+}
+
+// maxPageSize is the largest number of items requested per page from an
+// Appwrite list endpoint.
+const maxPageSize = 100
+
+// queryPageSize returns the number of items to request per page, which is the
+// query limit if that is smaller than maxPageSize. At least one item is
+// requested, since a page of none would never end the paging.
+func queryPageSize(d *plugin.QueryData) int64 {
+	pageSize := int64(maxPageSize)
+	if d != nil && d.QueryContext.Limit != nil && *d.QueryContext.Limit < pageSize {
+		pageSize = *d.QueryContext.Limit
+	}
+	if pageSize < 1 {
+		pageSize = 1
+	}
+	return pageSize
+}
+
+// listAll walks every page of the Appwrite list endpoint at path using cursor
+// pagination and passes each item found under key in the response to stream.
+// Paging stops once the results are exhausted, the query limit is reached or
+// no more rows are required. d may be nil when listing outside of a query.
+func listAll[T any](ctx context.Context, d *plugin.QueryData, conn *appwriteClient, path string, key string, params url.Values, queries []string, stream func(T)) error {
+	pageSize := queryPageSize(d)
+
+	cursor := ""
+	for {
+		pageQueries := append([]string{}, queries...)
+		pageQueries = append(pageQueries, newQuery("limit", "", pageSize))
+		if cursor != "" {
+			pageQueries = append(pageQueries, newQuery("cursorAfter", "", cursor))
+		}
+
+		pageParams := url.Values{}
+		for name, values := range params {
+			pageParams[name] = values
+		}
+		pageParams["queries[]"] = pageQueries
+
+		resp, err := getPage(ctx, d, conn, path, pageParams, cursor == "")
+		if err != nil {
+			return err
+		}
+		items, err := pageItems(resp, key)
+		if err != nil {
+			return err
+		}
+
+		for _, raw := range items {
+			var item T
+			if err := json.Unmarshal(raw, &item); err != nil {
+				return err
+			}
+			stream(item)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d != nil && d.RowsRemaining(ctx) == 0 {
+				return nil
+			}
+		}
+
+		if int64(len(items)) < pageSize {
+			return nil
+		}
+		var last struct {
+			Id string `json:"$id"`
+		}
+		if err := json.Unmarshal(items[len(items)-1], &last); err != nil {
+			return err
+		}
+		cursor = last.Id
+	}
+}
+
+// listAllByOffset is listAll paging with offsets, for items without an $id to
+// page after. Paging also stops once stream returns false.
+func listAllByOffset[T any](ctx context.Context, d *plugin.QueryData, conn *appwriteClient, path string, key string, queries []string, stream func(T) bool) error {
+	pageSize := queryPageSize(d)
+
+	for offset := int64(0); ; offset += pageSize {
+		pageQueries := append([]string{}, queries...)
+		pageQueries = append(pageQueries, newQuery("limit", "", pageSize), newQuery("offset", "", offset))
+
+		resp, err := getPage(ctx, d, conn, path, url.Values{"queries[]": pageQueries}, offset == 0)
+		if err != nil {
+			return err
+		}
+		items, err := pageItems(resp, key)
+		if err != nil {
+			return err
+		}
+
+		for _, raw := range items {
+			var item T
+			if err := json.Unmarshal(raw, &item); err != nil {
+				return err
+			}
+			if !stream(item) {
+				return nil
+			}
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d != nil && d.RowsRemaining(ctx) == 0 {
+				return nil
+			}
+		}
+
+		if int64(len(items)) < pageSize {
+			return nil
+		}
+	}
+}
+
+// getPage fetches a page of the Appwrite list endpoint at path. Steampipe only
+// retries a list until it streams its first row, and never retries the child
+// list of a parent, so those pages are retried here with the list's retry
+// config.
+func getPage(ctx context.Context, d *plugin.QueryData, conn *appwriteClient, path string, params url.Values, first bool) ([]byte, error) {
+	if d == nil || d.Table.List == nil || (first && d.Table.List.ParentHydrate == nil) {
+		return conn.get(ctx, path, params)
+	}
+	fetchPage := func(ctx context.Context, _ *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+		return conn.get(ctx, path, params)
+	}
+	resp, err := plugin.RetryHydrate(ctx, d, nil, fetchPage, d.Table.List.RetryConfig)
+	if err != nil {
+		return nil, err
+	}
+	return resp.([]byte), nil
+}
+
+// listUnpaged passes each item found under key in the response of the Appwrite
+// list endpoint at path, which doesn't support queries or pagination, to
+// stream.
+func listUnpaged[T any](ctx context.Context, d *plugin.QueryData, conn *appwriteClient, path string, key string, stream func(T)) error {
+	resp, err := getPage(ctx, d, conn, path, nil, true)
+	if err != nil {
+		return err
+	}
+	items, err := pageItems(resp, key)
+	if err != nil {
+		return err
+	}
+	for _, raw := range items {
+		var item T
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return err
+		}
+		stream(item)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d != nil && d.RowsRemaining(ctx) == 0 {
+			return nil
+		}
+	}
+	return nil
+}
+
+// pageItems returns the raw items found under key in a list response.
+func pageItems(resp []byte, key string) ([]json.RawMessage, error) {
+	var page map[string]json.RawMessage
+	if err := json.Unmarshal(resp, &page); err != nil {
+		return nil, err
+	}
+	var items []json.RawMessage
+	if raw, ok := page[key]; ok {
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+// searchParams returns the request params for an optional search term.
+func searchParams(search string) url.Values {
+	params := url.Values{}
+	if search != "" {
+		params.Set("search", search)
+	}
+	return params
+}
+
+// apiPath formats an API path with ids, escaping each so that an ID taken from
+// a qual, e.g. ../functions or x?search=y, can't request a different endpoint.
+func apiPath(format string, ids ...string) string {
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		escaped := url.PathEscape(id)
+		if escaped == "." || escaped == ".." {
+			escaped = strings.ReplaceAll(escaped, ".", "%2E")
+		}
+		args[i] = escaped
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// getOne fetches the single resource at the path formatted with ids from the
+// Appwrite API.
+func getOne[T any](ctx context.Context, conn *appwriteClient, format string, ids ...string) (T, error) {
+	var item T
+	for _, id := range ids {
+		// Empty IDs would request the list endpoint instead
+		if id == "" {
+			return item, &appwriteError{Code: http.StatusNotFound, Message: "empty ID"}
+		}
+	}
+
+	resp, err := conn.get(ctx, apiPath(format, ids...), nil)
+	if err != nil {
+		return item, err
+	}
+	err = json.Unmarshal(resp, &item)
+	return item, err
+}
+
+// maxChildListConcurrency bounds how many child lists of parent-child tables
+// run at once on a connection, as Steampipe starts a goroutine for every
+// parent item.
+const maxChildListConcurrency = 10
+
+// childList wraps the child list of a parent-child table to bound its
+// concurrency on the connection. Steampipe calls child lists without the table's ignore config,
+// so parents which no longer exist are skipped here.
+func childList(hydrate plugin.HydrateFunc) plugin.HydrateFunc {
+	return func(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+		conn, err := connect(ctx, d)
+		if err != nil {
+			return nil, err
+		}
+		select {
+		case conn.childLists <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		defer func() { <-conn.childLists }()
+
+		item, err := hydrate(ctx, d, h)
+		if err != nil && isNotFoundError(err) {
+			return nil, nil
+		}
+		return item, err
+	}
 }
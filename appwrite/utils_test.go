@@ -0,0 +1,486 @@
+package appwrite
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/ristretto"
+	"github.com/eko/gocache/v3/cache"
+	"github.com/eko/gocache/v3/store"
+	"github.com/hashicorp/go-hclog"
+	"github.com/turbot/steampipe-plugin-sdk/v5/connection"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/context_key"
+)
+
+// request is a request received by a stand-in Appwrite server.
+type request struct {
+	Path    string
+	Project string
+	Key     string
+	Format  string
+}
+
+// newStandInServer starts a stand-in Appwrite server which records the
+// requests it receives and answers them with an empty JSON object.
+func newStandInServer(t *testing.T) (*httptest.Server, *[]request) {
+	t.Helper()
+	var mu sync.Mutex
+	var requests []request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, request{
+			Path:    r.URL.Path,
+			Project: r.Header.Get("X-Appwrite-Project"),
+			Key:     r.Header.Get("X-Appwrite-Key"),
+			Format:  r.Header.Get("X-Appwrite-Response-Format"),
+		})
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("{}"))
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+// testContext returns a context carrying the logger plugin.Logger expects.
+func testContext() context.Context {
+	return context.WithValue(context.Background(), context_key.Logger, hclog.NewNullLogger())
+}
+
+// pageQueries returns the values of the JSON queries of a request by method.
+func pageQueries(t *testing.T, r *http.Request) map[string][]interface{} {
+	queries := map[string][]interface{}{}
+	for _, raw := range r.URL.Query()["queries[]"] {
+		var query appwriteQuery
+		if err := json.Unmarshal([]byte(raw), &query); err != nil {
+			t.Errorf("query %s isn't JSON: %v", raw, err)
+			continue
+		}
+		queries[query.Method] = query.Values
+	}
+	return queries
+}
+
+func clearEnv(t *testing.T) {
+	t.Helper()
+	t.Setenv("APPWRITE_ENDPOINT", "")
+	t.Setenv("APPWRITE_PROJECT_ID", "")
+	t.Setenv("APPWRITE_SECRET_KEY", "")
+}
+
+func TestResolveSettingsUsesConfiguredEndpoint(t *testing.T) {
+	clearEnv(t)
+	server, requests := newStandInServer(t)
+
+	endpoint, projectID, secretKey := server.URL+"/v1/", "project", "key"
+	settings, err := resolveSettings(appwriteConfig{Endpoint: &endpoint, ProjectID: &projectID, SecretKey: &secretKey})
+	if err != nil {
+		t.Fatalf("resolveSettings: %v", err)
+	}
+	if want := server.URL + "/v1"; settings.Endpoint != want {
+		t.Errorf("endpoint = %q, want %q", settings.Endpoint, want)
+	}
+
+	if _, err := settings.client().get(context.Background(), "/health", nil); err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	want := request{Path: "/v1/health", Project: "project", Key: "key", Format: responseFormat}
+	if len(*requests) != 1 || (*requests)[0] != want {
+		t.Errorf("requests = %+v, want [%+v]", *requests, want)
+	}
+}
+
+func TestResolveSettingsFallsBackToEnv(t *testing.T) {
+	server, requests := newStandInServer(t)
+	t.Setenv("APPWRITE_ENDPOINT", server.URL+"/v1")
+	t.Setenv("APPWRITE_PROJECT_ID", "env-project")
+	t.Setenv("APPWRITE_SECRET_KEY", "env-key")
+
+	settings, err := resolveSettings(appwriteConfig{})
+	if err != nil {
+		t.Fatalf("resolveSettings: %v", err)
+	}
+	if _, err := settings.client().get(context.Background(), "/health", nil); err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	want := request{Path: "/v1/health", Project: "env-project", Key: "env-key", Format: responseFormat}
+	if len(*requests) != 1 || (*requests)[0] != want {
+		t.Errorf("requests = %+v, want [%+v]", *requests, want)
+	}
+
+	// The connection config takes precedence over the env
+	projectID := "config-project"
+	settings, err = resolveSettings(appwriteConfig{ProjectID: &projectID})
+	if err != nil {
+		t.Fatalf("resolveSettings: %v", err)
+	}
+	if settings.ProjectID != projectID || settings.Endpoint != server.URL+"/v1" {
+		t.Errorf("settings = %+v, want the config project and the env endpoint", settings)
+	}
+}
+
+func TestResolveSettingsDefaultsToCloud(t *testing.T) {
+	clearEnv(t)
+	projectID, secretKey := "project", "key"
+	settings, err := resolveSettings(appwriteConfig{ProjectID: &projectID, SecretKey: &secretKey})
+	if err != nil {
+		t.Fatalf("resolveSettings: %v", err)
+	}
+	if settings.Endpoint != defaultEndpoint {
+		t.Errorf("endpoint = %q, want %q", settings.Endpoint, defaultEndpoint)
+	}
+}
+
+func TestResolveSettingsRejectsInvalidEndpoints(t *testing.T) {
+	clearEnv(t)
+	projectID, secretKey := "project", "key"
+	for _, endpoint := range []string{
+		"cloud.appwrite.io/v1",
+		"ftp://cloud.appwrite.io/v1",
+		"http://",
+		"https://[::1",
+		"/v1",
+	} {
+		endpoint := endpoint
+		_, err := resolveSettings(appwriteConfig{Endpoint: &endpoint, ProjectID: &projectID, SecretKey: &secretKey})
+		if err == nil {
+			t.Errorf("resolveSettings accepted endpoint %q", endpoint)
+		}
+	}
+}
+
+// newQueryData returns query data for the named connection to the Appwrite
+// project at endpoint, caching in store as Steampipe does.
+func newQueryData(store *cache.Cache[any], name string, endpoint string, projectID string) *plugin.QueryData {
+	secretKey := "key-" + projectID
+	return &plugin.QueryData{
+		Connection:      &plugin.Connection{Name: name, Config: appwriteConfig{Endpoint: &endpoint, ProjectID: &projectID, SecretKey: &secretKey}},
+		ConnectionCache: connection.NewConnectionCache(name, store),
+	}
+}
+
+func TestConnectionsDoNotShareCaches(t *testing.T) {
+	clearEnv(t)
+	var mu sync.Mutex
+	var requests []request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, request{
+			Path:    r.URL.Path,
+			Project: r.Header.Get("X-Appwrite-Project"),
+			Key:     r.Header.Get("X-Appwrite-Key"),
+			Format:  r.Header.Get("X-Appwrite-Response-Format"),
+		})
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"countries": [{"name": %q, "code": "XX"}]}`, r.Header.Get("X-Appwrite-Project"))
+	}))
+	t.Cleanup(server.Close)
+
+	ristrettoCache, err := ristretto.NewCache(&ristretto.Config{NumCounters: 1000, MaxCost: 100000, BufferItems: 64})
+	if err != nil {
+		t.Fatal(err)
+	}
+	connectionCacheStore := cache.New[any](store.NewRistretto(ristrettoCache))
+	ctx := testContext()
+	connections := []*plugin.QueryData{
+		newQueryData(connectionCacheStore, "a", server.URL+"/v1", "project-a"),
+		newQueryData(connectionCacheStore, "b", server.URL+"/v1", "project-b"),
+	}
+
+	clients := map[string]*appwriteClient{}
+	for _, d := range connections {
+		conn, err := connect(ctx, d)
+		if err != nil {
+			t.Fatalf("connect %s: %v", d.Connection.Name, err)
+		}
+		if want := "project-" + d.Connection.Name; conn.settings.ProjectID != want {
+			t.Errorf("connection %s client project = %q, want %q", d.Connection.Name, conn.settings.ProjectID, want)
+		}
+		clients[d.Connection.Name] = conn
+	}
+	if clients["a"] == clients["b"] {
+		t.Error("connections a and b share a client")
+	}
+
+	// Repeated queries reuse each connection's client and memoized results
+	for i := 0; i < 2; i++ {
+		for _, d := range connections {
+			conn, err := connect(ctx, d)
+			if err != nil {
+				t.Fatalf("connect %s: %v", d.Connection.Name, err)
+			}
+			if conn != clients[d.Connection.Name] {
+				t.Errorf("connection %s built a new client", d.Connection.Name)
+			}
+
+			items, err := listLocaleCountriesCached(ctx, d, nil)
+			if err != nil {
+				t.Fatalf("list countries %s: %v", d.Connection.Name, err)
+			}
+			countries := items.([]localeCountry)
+			if want := "project-" + d.Connection.Name; len(countries) != 1 || countries[0].Name != want {
+				t.Errorf("connection %s countries = %+v, want those of %s", d.Connection.Name, countries, want)
+			}
+		}
+	}
+
+	want := []request{
+		{Path: "/v1/locale/countries", Project: "project-a", Key: "key-project-a", Format: responseFormat},
+		{Path: "/v1/locale/countries", Project: "project-b", Key: "key-project-b", Format: responseFormat},
+	}
+	if len(requests) != len(want) || requests[0] != want[0] || requests[1] != want[1] {
+		t.Errorf("requests = %+v, want %+v", requests, want)
+	}
+}
+
+func TestListAllByOffsetPagesUntilStreamStops(t *testing.T) {
+	clearEnv(t)
+	var offsets []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var offset int
+		if values := pageQueries(t, r)["offset"]; values != nil {
+			offset = int(values[0].(float64))
+			offsets = append(offsets, offset)
+		}
+		var items []string
+		for i := offset; i < offset+maxPageSize && i < 250; i++ {
+			items = append(items, fmt.Sprintf(`{"n": %d}`, i))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"logs": [%s]}`, strings.Join(items, ","))
+	}))
+	t.Cleanup(server.Close)
+	conn := clientSettings{Endpoint: server.URL + "/v1", ProjectID: "project", SecretKey: "key"}.client()
+
+	var streamed int
+	err := listAllByOffset(testContext(), nil, conn, "/users/u/logs", "logs", nil, func(item struct{ N int }) bool {
+		if item.N != streamed {
+			t.Fatalf("item %d streamed as number %d", item.N, streamed)
+		}
+		streamed++
+		return true
+	})
+	if err != nil {
+		t.Fatalf("listAllByOffset: %v", err)
+	}
+	if streamed != 250 || fmt.Sprint(offsets) != "[0 100 200]" {
+		t.Errorf("streamed %d items with %v, want 250 with offsets 0, 100 and 200", streamed, offsets)
+	}
+
+	// Paging stops once stream returns false
+	offsets, streamed = nil, 0
+	err = listAllByOffset(testContext(), nil, conn, "/users/u/logs", "logs", nil, func(item struct{ N int }) bool {
+		streamed++
+		return item.N < 120
+	})
+	if err != nil {
+		t.Fatalf("listAllByOffset: %v", err)
+	}
+	if streamed != 121 || len(offsets) != 2 {
+		t.Errorf("streamed %d items in %d pages, want 121 in 2", streamed, len(offsets))
+	}
+}
+
+func TestListAllPagesWithCursors(t *testing.T) {
+	clearEnv(t)
+	var cursors []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := 0
+		if values := pageQueries(t, r)["cursorAfter"]; values != nil {
+			cursor := values[0].(string)
+			cursors = append(cursors, cursor)
+			_, _ = fmt.Sscanf(cursor, "item-%d", &start)
+			start++
+		}
+		var items []string
+		for i := start; i < start+maxPageSize && i < 250; i++ {
+			items = append(items, fmt.Sprintf(`{"$id": "item-%d", "n": %d}`, i, i))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"total": 250, "items": [%s]}`, strings.Join(items, ","))
+	}))
+	t.Cleanup(server.Close)
+	conn := clientSettings{Endpoint: server.URL + "/v1", ProjectID: "project", SecretKey: "key"}.client()
+
+	var streamed int
+	err := listAll(testContext(), nil, conn, "/items", "items", nil, nil, func(item struct{ N int }) {
+		if item.N != streamed {
+			t.Fatalf("item %d streamed as number %d", item.N, streamed)
+		}
+		streamed++
+	})
+	if err != nil {
+		t.Fatalf("listAll: %v", err)
+	}
+	if want := "item-99 item-199"; streamed != 250 || strings.Join(cursors, " ") != want {
+		t.Errorf("streamed %d items with %v, want 250 with %s", streamed, cursors, want)
+	}
+}
+
+func TestQueryPageSize(t *testing.T) {
+	for _, tc := range []struct {
+		limit *int64
+		want  int64
+	}{
+		{nil, maxPageSize},
+		{int64Pointer(500), maxPageSize},
+		{int64Pointer(10), 10},
+		{int64Pointer(0), 1},
+	} {
+		d := &plugin.QueryData{QueryContext: &plugin.QueryContext{Limit: tc.limit}}
+		if got := queryPageSize(d); got != tc.want {
+			t.Errorf("page size for limit %v = %d, want %d", tc.limit, got, tc.want)
+		}
+	}
+	if got := queryPageSize(nil); got != maxPageSize {
+		t.Errorf("page size without a query = %d, want %d", got, maxPageSize)
+	}
+}
+
+func int64Pointer(i int64) *int64 {
+	return &i
+}
+
+func TestGetPageRetriesChildLists(t *testing.T) {
+	clearEnv(t)
+	var mu sync.Mutex
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts++
+		w.Header().Set("Content-Type", "application/json")
+		if attempts%2 == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"message": "Server Error", "code": 503}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"items": []}`))
+	}))
+	t.Cleanup(server.Close)
+	conn := clientSettings{Endpoint: server.URL + "/v1", ProjectID: "project", SecretKey: "key"}.client()
+
+	newList := func(parent plugin.HydrateFunc) *plugin.QueryData {
+		return &plugin.QueryData{
+			Connection: &plugin.Connection{Name: "appwrite"},
+			Table: &plugin.Table{
+				Name:   "appwrite_item",
+				Plugin: &plugin.Plugin{Name: "appwrite"},
+				List: &plugin.ListConfig{
+					ParentHydrate: parent,
+					RetryConfig:   &plugin.RetryConfig{ShouldRetryErrorFunc: shouldRetryError, MaxAttempts: 3, BackoffAlgorithm: "Constant", RetryInterval: 10},
+				},
+			},
+		}
+	}
+	parent := func(context.Context, *plugin.QueryData, *plugin.HydrateData) (interface{}, error) { return nil, nil }
+
+	// Steampipe never retries child lists, so even their first page is retried
+	if _, err := getPage(testContext(), newList(parent), conn, "/items", nil, true); err != nil {
+		t.Errorf("first page of a child list: %v", err)
+	}
+	if _, err := getPage(testContext(), newList(nil), conn, "/items", nil, false); err != nil {
+		t.Errorf("later page of a list: %v", err)
+	}
+
+	// Steampipe retries the first page of a list itself
+	if _, err := getPage(testContext(), newList(nil), conn, "/items", nil, true); !shouldRetryError(context.Background(), nil, nil, err) {
+		t.Errorf("first page of a list: error = %v, want the server error for Steampipe to retry", err)
+	}
+}
+
+func TestGetOneEscapesIDs(t *testing.T) {
+	clearEnv(t)
+	var mu sync.Mutex
+	var uris []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		uris = append(uris, r.RequestURI)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("{}"))
+	}))
+	t.Cleanup(server.Close)
+	conn := clientSettings{Endpoint: server.URL + "/v1", ProjectID: "project", SecretKey: "key"}.client()
+
+	for id, want := range map[string]string{
+		"user.1":       "/v1/users/user.1",
+		"../functions": "/v1/users/..%2Ffunctions",
+		"x?search=y":   "/v1/users/x%3Fsearch=y",
+		"a#b":          "/v1/users/a%23b",
+		"..":           "/v1/users/%2E%2E",
+	} {
+		uris = nil
+		if _, err := getOne[struct{}](testContext(), conn, "/users/%s", id); err != nil {
+			t.Fatalf("getOne %q: %v", id, err)
+		}
+		if len(uris) != 1 || uris[0] != want {
+			t.Errorf("getOne %q requested %v, want %s", id, uris, want)
+		}
+	}
+
+	// Empty IDs are missing rather than requesting the list endpoint
+	uris = nil
+	if _, err := getOne[struct{}](testContext(), conn, "/users/%s", ""); !isNotFoundError(err) || len(uris) != 0 {
+		t.Errorf("getOne of an empty ID: error %v after requesting %v, want not found without a request", err, uris)
+	}
+}
+
+func TestChildListsAreBoundedPerConnection(t *testing.T) {
+	clearEnv(t)
+	ristrettoCache, err := ristretto.NewCache(&ristretto.Config{NumCounters: 1000, MaxCost: 100000, BufferItems: 64})
+	if err != nil {
+		t.Fatal(err)
+	}
+	connectionCacheStore := cache.New[any](store.NewRistretto(ristrettoCache))
+	busy := newQueryData(connectionCacheStore, "busy", defaultEndpoint, "project-a")
+	idle := newQueryData(connectionCacheStore, "idle", defaultEndpoint, "project-b")
+	ctx := testContext()
+
+	// Fill every child list slot of one connection
+	release := make(chan struct{})
+	var started sync.WaitGroup
+	var done sync.WaitGroup
+	blocked := childList(func(context.Context, *plugin.QueryData, *plugin.HydrateData) (interface{}, error) {
+		started.Done()
+		<-release
+		return nil, nil
+	})
+	for i := 0; i < maxChildListConcurrency; i++ {
+		started.Add(1)
+		done.Add(1)
+		go func() {
+			defer done.Done()
+			_, _ = blocked(ctx, busy, nil)
+		}()
+	}
+	started.Wait()
+
+	ran := false
+	list := childList(func(context.Context, *plugin.QueryData, *plugin.HydrateData) (interface{}, error) {
+		ran = true
+		return nil, nil
+	})
+	if _, err := list(ctx, idle, nil); err != nil || !ran {
+		t.Errorf("child list of another connection: ran %v, error %v", ran, err)
+	}
+
+	// The busy connection waits for a free slot
+	waitCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if _, err := list(waitCtx, busy, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("child list of the busy connection: error %v, want it to wait for a slot", err)
+	}
+	close(release)
+	done.Wait()
+}